@@ -0,0 +1,175 @@
+package falkordb
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// Result cache defaults.
+const (
+	defaultCacheTTL        = 10 * time.Second
+	defaultCacheMaxEntries = 1000
+)
+
+// CacheOptions configures the client-side cache of ROQuery results.
+//
+// Results are cached per graph, query text and parameter values. Every
+// Graph.Query that reports changes to a graph, and every Graph.Delete,
+// drops the cached results of that graph. Writes made by other clients are
+// only seen once a result expires, unless they publish on
+// InvalidationChannel as well.
+type CacheOptions struct {
+	// TTL is how long a result is served from the cache.
+	// Default: 10s
+	TTL time.Duration
+
+	// MaxEntries bounds the number of cached results. The least recently
+	// used result is evicted when the cache is full.
+	// Default: 1000
+	MaxEntries int
+
+	// InvalidationChannel, if set, is a Redis pub/sub channel on which the
+	// client announces writes and listens for writes announced by other
+	// clients, so the caches of several processes stay coherent.
+	InvalidationChannel string
+}
+
+func (o *CacheOptions) setDefaults() {
+	if o.TTL <= 0 {
+		o.TTL = defaultCacheTTL
+	}
+	if o.MaxEntries <= 0 {
+		o.MaxEntries = defaultCacheMaxEntries
+	}
+}
+
+// resultCache is an LRU cache of query results with per-entry expiry.
+type resultCache struct {
+	opts CacheOptions
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[cacheKey]*list.Element
+	lru     *list.List // front is most recently used
+
+	// generations counts invalidations per graph, so a read that started
+	// before a write cannot store its stale result after the write.
+	generations map[string]uint64
+
+	pubsub *goredis.PubSub
+}
+
+type cacheKey struct {
+	graph string
+	query string
+}
+
+type cacheEntry struct {
+	key     cacheKey
+	result  *QueryResult
+	expires time.Time
+}
+
+func newResultCache(opts CacheOptions) *resultCache {
+	opts.setDefaults()
+	return &resultCache{
+		opts:        opts,
+		now:         time.Now,
+		entries:     make(map[cacheKey]*list.Element),
+		lru:         list.New(),
+		generations: make(map[string]uint64),
+	}
+}
+
+// get returns a copy of the cached result for key, if any, along with the
+// graph generation to pass to put after a miss.
+func (c *resultCache) get(key cacheKey) (*QueryResult, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	gen := c.generations[key.graph]
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, gen
+	}
+	entry := elem.Value.(*cacheEntry)
+	if !c.now().Before(entry.expires) {
+		c.remove(elem)
+		return nil, gen
+	}
+	c.lru.MoveToFront(elem)
+	return entry.result.clone(), gen
+}
+
+// put stores a copy of result unless the graph was invalidated since gen
+// was read, so the caller remains free to modify result.
+func (c *resultCache) put(key cacheKey, gen uint64, result *QueryResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations[key.graph] != gen {
+		return
+	}
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+	for c.lru.Len() >= c.opts.MaxEntries {
+		c.remove(c.lru.Back())
+	}
+	entry := &cacheEntry{key: key, result: result.clone(), expires: c.now().Add(c.opts.TTL)}
+	c.entries[key] = c.lru.PushFront(entry)
+}
+
+// invalidate drops the cached results of graph.
+func (c *resultCache) invalidate(graph string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[graph]++
+	for key, elem := range c.entries {
+		if key.graph == graph {
+			c.remove(elem)
+		}
+	}
+}
+
+func (c *resultCache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*cacheEntry).key)
+}
+
+// listen invalidates graphs announced on pubsub until it is closed.
+func (c *resultCache) listen(pubsub *goredis.PubSub) {
+	c.pubsub = pubsub
+	go func() {
+		for msg := range pubsub.Channel() {
+			c.invalidate(msg.Payload)
+		}
+	}()
+}
+
+func (c *resultCache) close() error {
+	if c.pubsub == nil {
+		return nil
+	}
+	return c.pubsub.Close()
+}
+
+// invalidateCache records a write to the named graph: reads that start
+// afterwards no longer share calls that began before it, and its cached
+// results are dropped and the write announced on the invalidation channel,
+// if one is configured.
+func (g *Graph) invalidateCache(ctx context.Context, graph string) {
+	g.flights.invalidate(graph)
+	if g.cache == nil {
+		return
+	}
+	g.cache.invalidate(graph)
+	if ch := g.cache.opts.InvalidationChannel; ch != "" {
+		_ = g.client.Do(ctx, "PUBLISH", ch, graph).Err()
+	}
+}
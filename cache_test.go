@@ -0,0 +1,182 @@
+package falkordb
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResultCacheExpiry(t *testing.T) {
+	now := time.Unix(0, 0)
+	cache := newResultCache(CacheOptions{TTL: time.Second})
+	cache.now = func() time.Time { return now }
+
+	key := cacheKey{graph: "g", query: "RETURN 1"}
+	_, gen := cache.get(key)
+	result := &QueryResult{}
+	cache.put(key, gen, result)
+
+	if got, _ := cache.get(key); got == nil {
+		t.Errorf("Expected cached result")
+	}
+	now = now.Add(time.Second)
+	if got, _ := cache.get(key); got != nil {
+		t.Errorf("Expected expired result to be dropped")
+	}
+	if len(cache.entries) != 0 || cache.lru.Len() != 0 {
+		t.Errorf("Expired entry was not removed")
+	}
+}
+
+func TestResultCacheCopies(t *testing.T) {
+	cache := newResultCache(CacheOptions{})
+	key := cacheKey{graph: "g", query: "RETURN 1 AS n"}
+
+	result := &QueryResult{Data: []map[string]interface{}{{"n": int64(1)}}}
+	cache.put(key, 0, result)
+	result.Data[0]["n"] = "mutated by the caller that filled the cache"
+
+	first, _ := cache.get(key)
+	if first == nil || first == result || first.Data[0]["n"] != int64(1) {
+		t.Fatalf("Expected an unmodified copy, got %+v", first)
+	}
+	first.Data[0]["n"] = "mutated by a cache hit"
+
+	second, _ := cache.get(key)
+	if second == first || second.Data[0]["n"] != int64(1) {
+		t.Errorf("Expected each hit to get its own copy, got %+v", second)
+	}
+}
+
+func TestResultCacheEviction(t *testing.T) {
+	cache := newResultCache(CacheOptions{MaxEntries: 2})
+	a, b, c := cacheKey{"g", "a"}, cacheKey{"g", "b"}, cacheKey{"g", "c"}
+
+	cache.put(a, 0, &QueryResult{})
+	cache.put(b, 0, &QueryResult{})
+	cache.get(a) // a is now more recently used than b
+	cache.put(c, 0, &QueryResult{})
+
+	if got, _ := cache.get(b); got != nil {
+		t.Error("Expected least recently used entry to be evicted")
+	}
+	for _, key := range []cacheKey{a, c} {
+		if got, _ := cache.get(key); got == nil {
+			t.Errorf("Expected %v to be cached", key)
+		}
+	}
+}
+
+func TestResultCacheInvalidate(t *testing.T) {
+	cache := newResultCache(CacheOptions{})
+	mine, other := cacheKey{"mine", "q"}, cacheKey{"other", "q"}
+
+	_, gen := cache.get(mine)
+	cache.put(mine, gen, &QueryResult{})
+	cache.put(other, 0, &QueryResult{})
+
+	cache.invalidate("mine")
+	if got, _ := cache.get(mine); got != nil {
+		t.Error("Expected invalidated graph to be dropped")
+	}
+	if got, _ := cache.get(other); got == nil {
+		t.Error("Expected other graphs to stay cached")
+	}
+
+	// A read that started before the write must not store its result
+	cache.put(mine, gen, &QueryResult{})
+	if got, _ := cache.get(mine); got != nil {
+		t.Error("Stale result was stored after invalidation")
+	}
+}
+
+func TestGraphResultCache(t *testing.T) {
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		if args[0] == "GRAPH.QUERY" && args[2] == "CREATE ()" {
+			return []interface{}{[]interface{}{"Nodes created: 1"}}, nil
+		}
+		return []interface{}{[]interface{}{}}, nil
+	}}
+	db := &FalkorDB{client: client, opts: &Options{}, cache: newResultCache(CacheOptions{InvalidationChannel: "writes"})}
+	graph := db.SelectGraph("test")
+	ctx := context.Background()
+
+	// roQueries counts the test queries sent, ignoring metadata lookups
+	roQueries := func() int {
+		n := 0
+		for _, args := range client.commands {
+			if args[0] == "GRAPH.RO_QUERY" && strings.HasPrefix(args[2].(string), "CYPHER") {
+				n++
+			}
+		}
+		return n
+	}
+	query := func(params map[string]interface{}) {
+		t.Helper()
+		if _, err := graph.ROQuery(ctx, "MATCH (n {a: $a, b: $b}) RETURN n", WithParams(params)); err != nil {
+			t.Fatalf("ROQuery failed: %v", err)
+		}
+	}
+
+	query(map[string]interface{}{"a": 1, "b": 2})
+	base := roQueries()
+	query(map[string]interface{}{"b": 2, "a": 1})
+	if roQueries() != base {
+		t.Errorf("Expected identical query to be served from the cache")
+	}
+
+	query(map[string]interface{}{"a": 1, "b": 3})
+	if roQueries() == base {
+		t.Errorf("Expected different parameters to miss the cache")
+	}
+
+	// Writes without changes keep the cache
+	if _, err := graph.Query(ctx, "MATCH (n) RETURN n"); err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	base = roQueries()
+	query(map[string]interface{}{"a": 1, "b": 2})
+	if roQueries() != base {
+		t.Errorf("Expected read-only query to keep the cache")
+	}
+
+	if _, err := graph.Query(ctx, "CREATE ()"); err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if client.count("PUBLISH") != 1 {
+		t.Errorf("Expected write to be published")
+	}
+	base = roQueries()
+	query(map[string]interface{}{"a": 1, "b": 2})
+	if roQueries() != base+1 {
+		t.Errorf("Expected write to invalidate the cache")
+	}
+}
+
+func TestProfileInvalidatesCache(t *testing.T) {
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		if args[0] == "GRAPH.PROFILE" {
+			return []interface{}{"Create | Records produced: 1, Execution time: 0.1 ms"}, nil
+		}
+		return []interface{}{[]interface{}{}}, nil
+	}}
+	db := &FalkorDB{client: client, opts: &Options{}, cache: newResultCache(CacheOptions{})}
+	graph := db.SelectGraph("test")
+	ctx := context.Background()
+
+	if _, err := graph.ROQuery(ctx, "MATCH (n) RETURN count(n)"); err != nil {
+		t.Fatalf("ROQuery failed: %v", err)
+	}
+	key := cacheKey{graph: "test", query: "MATCH (n) RETURN count(n)"}
+	if cached, _ := db.cache.get(key); cached == nil {
+		t.Fatal("Expected ROQuery result to be cached")
+	}
+
+	if _, err := graph.Profile(ctx, "CREATE ()"); err != nil {
+		t.Fatalf("Profile failed: %v", err)
+	}
+	if cached, _ := db.cache.get(key); cached != nil {
+		t.Error("Expected Profile to invalidate the cache")
+	}
+}
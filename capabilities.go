@@ -0,0 +1,117 @@
+package falkordb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+)
+
+// Feature is a server feature that requires a minimum FalkorDB version.
+type Feature string
+
+// Features checked by Capabilities.
+const (
+	FeatureVectorIndex Feature = "vector indexes"
+	FeatureConstraints Feature = "GRAPH.CONSTRAINT"
+	FeatureCopy        Feature = "GRAPH.COPY"
+	FeatureUDF         Feature = "user-defined functions"
+)
+
+// featureVersions are the FalkorDB module versions that introduced each
+// feature.
+var featureVersions = map[Feature]Version{
+	FeatureVectorIndex: {Major: 4, Minor: 0, Patch: 0},
+	FeatureConstraints: {Major: 2, Minor: 12, Patch: 0},
+	FeatureCopy:        {Major: 4, Minor: 0, Patch: 0},
+	FeatureUDF:         {Major: 4, Minor: 10, Patch: 0},
+}
+
+// Capabilities describes what the connected server supports, based on the
+// version of its FalkorDB module.
+type Capabilities struct {
+	// Detected reports whether the module version is known. When it is
+	// not, every feature is assumed to be supported.
+	Detected bool
+
+	// Version is the FalkorDB module version.
+	Version Version
+}
+
+// Supports reports whether the server supports feature.
+func (c Capabilities) Supports(feature Feature) bool {
+	return c.Check(feature) == nil
+}
+
+// Check returns an *UnsupportedError if the server is known to be too old
+// for feature, and nil otherwise.
+func (c Capabilities) Check(feature Feature) error {
+	required, ok := featureVersions[feature]
+	if !c.Detected || !ok || c.Version.Compare(required) >= 0 {
+		return nil
+	}
+	return &UnsupportedError{Feature: feature, Required: required, Server: c.Version}
+}
+
+// UnsupportedError is returned when a helper needs a newer FalkorDB version
+// than the server runs. It matches ErrUnsupported with errors.Is.
+type UnsupportedError struct {
+	Feature  Feature
+	Required Version
+	Server   Version
+}
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("%s: %s unsupported by server version %s (requires %s)",
+		ErrUnsupported, e.Feature, e.Server, e.Required)
+}
+
+func (e *UnsupportedError) Unwrap() error {
+	return ErrUnsupported
+}
+
+// Capabilities returns the capabilities detected by Options.DetectCapabilities
+// or DetectCapabilities. Until detection succeeds, Detected is false.
+func (db *FalkorDB) Capabilities() Capabilities {
+	if c := db.caps.Load(); c != nil {
+		return *c
+	}
+	return Capabilities{}
+}
+
+// DetectCapabilities reads the FalkorDB module version with MODULE LIST and
+// records it, so helpers that need a newer server fail with ErrUnsupported
+// before sending their command. In cluster mode a single node is asked.
+func (db *FalkorDB) DetectCapabilities(ctx context.Context) (Capabilities, error) {
+	result, err := db.client.Do(ctx, "MODULE", "LIST").Result()
+	if err != nil {
+		return Capabilities{}, err
+	}
+	modules, ok := result.([]interface{})
+	if !ok {
+		return Capabilities{}, fmt.Errorf("unexpected MODULE LIST result format: %T", result)
+	}
+
+	for _, m := range modules {
+		fields, err := proto.ParsePairs(m)
+		if err != nil {
+			return Capabilities{}, err
+		}
+		if proto.ToString(fields["name"]) != falkorModule {
+			continue
+		}
+		caps := Capabilities{Detected: true, Version: moduleVersion(proto.ToInt(fields["ver"]))}
+		db.caps.Store(&caps)
+		return caps, nil
+	}
+	return Capabilities{}, fmt.Errorf("falkordb: module %q is not loaded", falkorModule)
+}
+
+// require returns an *UnsupportedError if the server is known to be too old
+// for feature.
+func (g *Graph) require(feature Feature) error {
+	if c := g.caps.Load(); c != nil {
+		return c.Check(feature)
+	}
+	return nil
+}
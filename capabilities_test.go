@@ -0,0 +1,104 @@
+package falkordb
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func moduleListClient(ver int64) *fakeClient {
+	return &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		if args[0] == "MODULE" {
+			return []interface{}{
+				[]interface{}{"name", "ReJSON", "ver", int64(20609), "path", "/usr/lib/rejson.so", "args", []interface{}{}},
+				map[interface{}]interface{}{"name": "graph", "ver": ver, "path": "/usr/lib/falkordb.so", "args": []interface{}{}},
+			}, nil
+		}
+		return []interface{}{[]interface{}{}}, nil
+	}}
+}
+
+func TestDetectCapabilities(t *testing.T) {
+	db := &FalkorDB{client: moduleListClient(41202), opts: &Options{}}
+
+	if db.Capabilities().Detected {
+		t.Fatal("Expected capabilities to be undetected before DetectCapabilities")
+	}
+
+	caps, err := db.DetectCapabilities(context.Background())
+	if err != nil {
+		t.Fatalf("DetectCapabilities failed: %v", err)
+	}
+	if !caps.Detected || caps.Version != (Version{4, 12, 2}) {
+		t.Errorf("Unexpected capabilities: %+v", caps)
+	}
+	if db.Capabilities() != caps {
+		t.Errorf("Capabilities() = %+v, expected %+v", db.Capabilities(), caps)
+	}
+	for _, f := range []Feature{FeatureVectorIndex, FeatureConstraints, FeatureCopy, FeatureUDF} {
+		if !caps.Supports(f) {
+			t.Errorf("Expected %s to be supported by %s", f, caps.Version)
+		}
+	}
+}
+
+func TestDetectCapabilitiesWithoutModule(t *testing.T) {
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		return []interface{}{}, nil
+	}}
+	db := &FalkorDB{client: client, opts: &Options{}}
+
+	if _, err := db.DetectCapabilities(context.Background()); err == nil {
+		t.Error("Expected an error when the graph module is not loaded")
+	}
+	if db.Capabilities().Detected {
+		t.Error("Expected capabilities to stay undetected")
+	}
+}
+
+func TestUnsupportedFeatures(t *testing.T) {
+	client := moduleListClient(21205)
+	db := &FalkorDB{client: client, opts: &Options{}}
+	graph := db.SelectGraph("test")
+	ctx := context.Background()
+
+	// Nothing is gated before detection.
+	if _, err := graph.CreateNodeVectorIndex(ctx, "Doc", 3, "euclidean", "embedding"); err != nil {
+		t.Fatalf("CreateNodeVectorIndex failed before detection: %v", err)
+	}
+
+	if _, err := db.DetectCapabilities(ctx); err != nil {
+		t.Fatalf("DetectCapabilities failed: %v", err)
+	}
+	client.commands = nil
+
+	_, err := graph.CreateNodeVectorIndex(ctx, "Doc", 3, "euclidean", "embedding")
+	var unsupported *UnsupportedError
+	if !errors.As(err, &unsupported) || !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("Expected *UnsupportedError, got %v", err)
+	}
+	if unsupported.Feature != FeatureVectorIndex || unsupported.Server != (Version{2, 12, 5}) {
+		t.Errorf("Unexpected error fields: %+v", unsupported)
+	}
+	expectedMsg := "falkordb: unsupported by server: vector indexes unsupported by server version 2.12.5 (requires 4.0.0)"
+	if err.Error() != expectedMsg {
+		t.Errorf("Error() = %q, expected %q", err.Error(), expectedMsg)
+	}
+
+	if _, err := graph.DropEdgeVectorIndex(ctx, "Doc", "embedding"); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("DropEdgeVectorIndex error = %v, expected ErrUnsupported", err)
+	}
+	if err := graph.Copy(ctx, "backup"); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("Copy error = %v, expected ErrUnsupported", err)
+	}
+	if len(client.commands) != 0 {
+		t.Errorf("Expected no commands for unsupported helpers, got %v", client.commands)
+	}
+
+	if err := graph.ConstraintCreate(ctx, ConstraintUnique, EntityNode, "Person", "email"); err != nil {
+		t.Errorf("ConstraintCreate failed on 2.12.5: %v", err)
+	}
+	if _, err := graph.CreateNodeRangeIndex(ctx, "Person", "name"); err != nil {
+		t.Errorf("CreateNodeRangeIndex failed: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package falkordb
+
+import (
+	"context"
+	"sync"
+
+	"github.com/flancast90/falkordb-go/internal/redis"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// fakeClient is an in-memory redis.Client that records commands and
+// answers them with a handler.
+type fakeClient struct {
+	mu       sync.Mutex
+	commands [][]interface{}
+	handler  func(args []interface{}) (interface{}, error)
+
+	// nodes is returned by Nodes; when empty the client is a single node.
+	nodes []redis.Node
+}
+
+func (c *fakeClient) Do(ctx context.Context, args ...interface{}) *goredis.Cmd {
+	c.mu.Lock()
+	c.commands = append(c.commands, args)
+	handler := c.handler
+	c.mu.Unlock()
+
+	cmd := goredis.NewCmd(ctx, args...)
+	if handler == nil {
+		cmd.SetVal([]interface{}{[]interface{}{}})
+		return cmd
+	}
+	val, err := handler(args)
+	if err != nil {
+		cmd.SetErr(err)
+	} else {
+		cmd.SetVal(val)
+	}
+	return cmd
+}
+
+func (c *fakeClient) DoReplica(ctx context.Context, key string, args ...interface{}) *goredis.Cmd {
+	return c.Do(ctx, args...)
+}
+
+func (c *fakeClient) ReadTargets(ctx context.Context, key string) []redis.Target {
+	return []redis.Target{c.Do}
+}
+
+func (c *fakeClient) Subscribe(ctx context.Context, channels ...string) *goredis.PubSub {
+	return nil
+}
+
+func (c *fakeClient) Nodes(ctx context.Context) ([]redis.Node, error) {
+	if len(c.nodes) > 0 {
+		return c.nodes, nil
+	}
+	return []redis.Node{{Addr: "localhost:6379", Primary: true, Do: c.Do}}, nil
+}
+
+func (c *fakeClient) Close() error {
+	return nil
+}
+
+func (c *fakeClient) Ping(ctx context.Context) *goredis.StatusCmd {
+	return goredis.NewStatusCmd(ctx, "PING")
+}
+
+// count returns how many recorded commands start with name.
+func (c *fakeClient) count(name string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	n := 0
+	for _, args := range c.commands {
+		if len(args) > 0 && args[0] == name {
+			n++
+		}
+	}
+	return n
+}
+
+// serverError mimics an error reply from the server.
+type serverError string
+
+func (e serverError) Error() string { return string(e) }
+func (serverError) RedisError()     {}
+
+// newTestGraph returns a graph backed by a fakeClient.
+func newTestGraph(client *fakeClient, defaults ...QueryOption) *Graph {
+	db := &FalkorDB{client: client, opts: &Options{}}
+	return db.SelectGraph("test", defaults...)
+}
@@ -0,0 +1,315 @@
+package falkordb
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+)
+
+// Config holds the server configuration returned by ConfigGetAll.
+// Settings this client does not know are kept in Other.
+type Config struct {
+	// ThreadCount is the number of threads executing queries. Load-time only.
+	ThreadCount int64
+	// OMPThreadCount is the number of OpenMP threads per query. Load-time only.
+	OMPThreadCount int64
+	// CacheSize is the number of query plans cached per thread.
+	CacheSize int64
+	// Timeout is the deprecated timeout for read queries; 0 means none.
+	Timeout time.Duration
+	// TimeoutDefault is the timeout of queries that do not set one.
+	TimeoutDefault time.Duration
+	// TimeoutMax is the largest timeout a query may set.
+	TimeoutMax time.Duration
+	// ResultSetSize is the maximum number of rows returned; -1 means unlimited.
+	ResultSetSize int64
+	// QueryMemCapacity is the memory a query may use, in bytes; 0 means unlimited.
+	QueryMemCapacity int64
+	// MaxQueuedQueries is the number of queries that may wait for a thread.
+	MaxQueuedQueries int64
+	// VKeyMaxEntityCount is the number of entities per virtual key in RDB files.
+	VKeyMaxEntityCount int64
+	// NodeCreationBuffer is the number of node slots allocated ahead of creation.
+	NodeCreationBuffer int64
+	// DeltaMaxPendingChanges is the number of pending matrix changes before a flush.
+	DeltaMaxPendingChanges int64
+	// EffectsThreshold is the execution time above which writes are replicated as effects.
+	EffectsThreshold time.Duration
+	// CmdInfo reports whether query information is collected for GRAPH.INFO.
+	CmdInfo bool
+	// MaxInfoQueries is the number of finished queries kept for GRAPH.INFO.
+	MaxInfoQueries int64
+	// BoltPort is the port of the Bolt protocol listener; -1 means disabled.
+	BoltPort int64
+	// ImportFolder is the directory LOAD CSV reads from.
+	ImportFolder string
+
+	// Other holds settings not recognized by this client, keyed by name.
+	Other map[string]interface{}
+
+	// present holds the known settings included in the server's reply.
+	// It is nil for a Config built by hand, whose settings are all present.
+	present map[string]bool
+}
+
+// configSetting describes a known configuration key and the Config field
+// holding it. unit is the duration of one unit for time.Duration fields.
+type configSetting struct {
+	name  string
+	field func(c *Config) interface{}
+	unit  time.Duration
+}
+
+var configSettings = []configSetting{
+	{name: "THREAD_COUNT", field: func(c *Config) interface{} { return &c.ThreadCount }},
+	{name: "OMP_THREAD_COUNT", field: func(c *Config) interface{} { return &c.OMPThreadCount }},
+	{name: "CACHE_SIZE", field: func(c *Config) interface{} { return &c.CacheSize }},
+	{name: "TIMEOUT", field: func(c *Config) interface{} { return &c.Timeout }, unit: time.Millisecond},
+	{name: "TIMEOUT_DEFAULT", field: func(c *Config) interface{} { return &c.TimeoutDefault }, unit: time.Millisecond},
+	{name: "TIMEOUT_MAX", field: func(c *Config) interface{} { return &c.TimeoutMax }, unit: time.Millisecond},
+	{name: "RESULTSET_SIZE", field: func(c *Config) interface{} { return &c.ResultSetSize }},
+	{name: "QUERY_MEM_CAPACITY", field: func(c *Config) interface{} { return &c.QueryMemCapacity }},
+	{name: "MAX_QUEUED_QUERIES", field: func(c *Config) interface{} { return &c.MaxQueuedQueries }},
+	{name: "VKEY_MAX_ENTITY_COUNT", field: func(c *Config) interface{} { return &c.VKeyMaxEntityCount }},
+	{name: "NODE_CREATION_BUFFER", field: func(c *Config) interface{} { return &c.NodeCreationBuffer }},
+	{name: "DELTA_MAX_PENDING_CHANGES", field: func(c *Config) interface{} { return &c.DeltaMaxPendingChanges }},
+	{name: "EFFECTS_THRESHOLD", field: func(c *Config) interface{} { return &c.EffectsThreshold }, unit: time.Microsecond},
+	{name: "CMD_INFO", field: func(c *Config) interface{} { return &c.CmdInfo }},
+	{name: "MAX_INFO_QUERIES", field: func(c *Config) interface{} { return &c.MaxInfoQueries }},
+	{name: "BOLT_PORT", field: func(c *Config) interface{} { return &c.BoltPort }},
+	{name: "IMPORT_FOLDER", field: func(c *Config) interface{} { return &c.ImportFolder }},
+}
+
+// lookupSetting returns the known setting named key, ignoring case.
+func lookupSetting(key string) (configSetting, bool) {
+	for _, s := range configSettings {
+		if strings.EqualFold(s.name, key) {
+			return s, true
+		}
+	}
+	return configSetting{}, false
+}
+
+// ConfigGet retrieves a FalkorDB configuration value.
+//
+// Example:
+//
+//	value, _ := db.ConfigGet(ctx, "RESULTSET_SIZE")
+func (db *FalkorDB) ConfigGet(ctx context.Context, key string) (interface{}, error) {
+	result, err := db.client.Do(ctx, "GRAPH.CONFIG", "GET", key).Result()
+	if err != nil {
+		return nil, wrapError(err, "", "")
+	}
+
+	if arr, ok := result.([]interface{}); ok && len(arr) >= 2 {
+		return arr[1], nil
+	}
+	return result, nil
+}
+
+// ConfigGetAll retrieves every configuration value of the server.
+func (db *FalkorDB) ConfigGetAll(ctx context.Context) (*Config, error) {
+	result, err := db.client.Do(ctx, "GRAPH.CONFIG", "GET", "*").Result()
+	if err != nil {
+		return nil, wrapError(err, "", "")
+	}
+	return parseConfig(result)
+}
+
+// parseConfig decodes a GRAPH.CONFIG GET * reply: a list of name/value pairs.
+func parseConfig(result interface{}) (*Config, error) {
+	arr, ok := result.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected config result format: %T", result)
+	}
+
+	config := &Config{present: make(map[string]bool)}
+	for _, item := range arr {
+		pair, ok := item.([]interface{})
+		if !ok || len(pair) != 2 {
+			return nil, fmt.Errorf("unexpected config entry: %v", item)
+		}
+		name, value := proto.ToString(pair[0]), pair[1]
+
+		setting, ok := lookupSetting(name)
+		if !ok {
+			if config.Other == nil {
+				config.Other = make(map[string]interface{})
+			}
+			config.Other[name] = value
+			continue
+		}
+
+		config.present[setting.name] = true
+		switch field := setting.field(config).(type) {
+		case *int64:
+			*field = proto.ToInt64(value)
+		case *time.Duration:
+			*field = time.Duration(proto.ToInt64(value)) * setting.unit
+		case *bool:
+			*field = parseConfigBool(value)
+		case *string:
+			*field = proto.ToString(value)
+		}
+	}
+	return config, nil
+}
+
+func parseConfigBool(v interface{}) bool {
+	switch val := v.(type) {
+	case int64:
+		return val != 0
+	case string:
+		switch strings.ToLower(val) {
+		case "yes", "true", "1":
+			return true
+		}
+	}
+	return false
+}
+
+// ConfigSet sets a FalkorDB configuration value.
+//
+// Values of known settings are checked before they are sent, and fail with
+// ErrInvalidConfig if they have the wrong type: integer settings take any
+// integer type, timeout settings an integer in the server's unit or a
+// time.Duration, CMD_INFO a bool and IMPORT_FOLDER a string. Unknown
+// settings are sent as is.
+//
+// Example:
+//
+//	err := db.ConfigSet(ctx, "RESULTSET_SIZE", 10000)
+//	err = db.ConfigSet(ctx, "TIMEOUT_DEFAULT", 5*time.Second)
+func (db *FalkorDB) ConfigSet(ctx context.Context, key string, value interface{}) error {
+	if setting, ok := lookupSetting(key); ok {
+		encoded, err := setting.encode(value)
+		if err != nil {
+			return err
+		}
+		value = encoded
+	}
+	return wrapError(db.client.Do(ctx, "GRAPH.CONFIG", "SET", key, value).Err(), "", "")
+}
+
+// encode checks value against the type of the setting and converts it to
+// the form the server expects.
+func (s configSetting) encode(value interface{}) (interface{}, error) {
+	switch s.field(&Config{}).(type) {
+	case *time.Duration:
+		if d, ok := value.(time.Duration); ok {
+			if d < 0 || d%s.unit != 0 {
+				return nil, fmt.Errorf("%w: %s must be a non-negative whole number of %v, got %v", ErrInvalidConfig, s.name, s.unit, d)
+			}
+			return strconv.FormatInt(int64(d/s.unit), 10), nil
+		}
+		if n, ok := configInt(value); ok {
+			return strconv.FormatInt(n, 10), nil
+		}
+	case *int64:
+		if n, ok := configInt(value); ok {
+			return strconv.FormatInt(n, 10), nil
+		}
+	case *bool:
+		if b, ok := value.(bool); ok {
+			if b {
+				return "yes", nil
+			}
+			return "no", nil
+		}
+	case *string:
+		if str, ok := value.(string); ok {
+			return str, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s expects %s, got %T", ErrInvalidConfig, s.name, s.expects(), value)
+}
+
+// expects describes the values a setting accepts.
+func (s configSetting) expects() string {
+	switch s.field(&Config{}).(type) {
+	case *time.Duration:
+		return "a time.Duration or integer"
+	case *bool:
+		return "a bool"
+	case *string:
+		return "a string"
+	default:
+		return "an integer"
+	}
+}
+
+// configInt converts any Go integer, or a float with an integral value, to int64.
+func configInt(value interface{}) (int64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if v.Uint() > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if f != math.Trunc(f) || math.Abs(f) > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(f), true
+	}
+	return 0, false
+}
+
+// ConfigDiff is a setting whose value differs between two configurations.
+// A value is nil when the setting is missing from that configuration, such
+// as a setting an older server does not have.
+type ConfigDiff struct {
+	Key  string
+	A, B interface{}
+}
+
+// DiffConfig compares two configurations, such as those of two servers,
+// and returns the settings that differ, sorted by key.
+func DiffConfig(a, b *Config) []ConfigDiff {
+	av, bv := a.values(), b.values()
+
+	keys := make(map[string]bool, len(av))
+	for k := range av {
+		keys[k] = true
+	}
+	for k := range bv {
+		keys[k] = true
+	}
+
+	var diffs []ConfigDiff
+	for k := range keys {
+		if !reflect.DeepEqual(av[k], bv[k]) {
+			diffs = append(diffs, ConfigDiff{Key: k, A: av[k], B: bv[k]})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Key < diffs[j].Key })
+	return diffs
+}
+
+// values returns the settings of c keyed by name, leaving out known
+// settings the server did not report.
+func (c *Config) values() map[string]interface{} {
+	values := make(map[string]interface{}, len(configSettings)+len(c.Other))
+	for _, s := range configSettings {
+		if c.present != nil && !c.present[s.name] {
+			continue
+		}
+		values[s.name] = reflect.ValueOf(s.field(c)).Elem().Interface()
+	}
+	for k, v := range c.Other {
+		values[k] = v
+	}
+	return values
+}
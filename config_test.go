@@ -0,0 +1,168 @@
+package falkordb
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConfigGetAll(t *testing.T) {
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		return []interface{}{
+			[]interface{}{"TIMEOUT", int64(0)},
+			[]interface{}{"TIMEOUT_DEFAULT", int64(5000)},
+			[]interface{}{"RESULTSET_SIZE", int64(-1)},
+			[]interface{}{"QUERY_MEM_CAPACITY", int64(1048576)},
+			[]interface{}{"THREAD_COUNT", int64(8)},
+			[]interface{}{"CACHE_SIZE", int64(25)},
+			[]interface{}{"EFFECTS_THRESHOLD", int64(300)},
+			[]interface{}{"CMD_INFO", "yes"},
+			[]interface{}{"IMPORT_FOLDER", "/var/lib/FalkorDB/import/"},
+			[]interface{}{"FUTURE_SETTING", int64(3)},
+		}, nil
+	}}
+	db := &FalkorDB{client: client, opts: &Options{}}
+
+	config, err := db.ConfigGetAll(context.Background())
+	if err != nil {
+		t.Fatalf("ConfigGetAll failed: %v", err)
+	}
+
+	expected := &Config{
+		TimeoutDefault:   5 * time.Second,
+		ResultSetSize:    -1,
+		QueryMemCapacity: 1048576,
+		ThreadCount:      8,
+		CacheSize:        25,
+		EffectsThreshold: 300 * time.Microsecond,
+		CmdInfo:          true,
+		ImportFolder:     "/var/lib/FalkorDB/import/",
+		Other:            map[string]interface{}{"FUTURE_SETTING": int64(3)},
+		present: map[string]bool{
+			"TIMEOUT": true, "TIMEOUT_DEFAULT": true, "RESULTSET_SIZE": true,
+			"QUERY_MEM_CAPACITY": true, "THREAD_COUNT": true, "CACHE_SIZE": true,
+			"EFFECTS_THRESHOLD": true, "CMD_INFO": true, "IMPORT_FOLDER": true,
+		},
+	}
+	if !reflect.DeepEqual(config, expected) {
+		t.Errorf("ConfigGetAll = %+v, expected %+v", config, expected)
+	}
+
+	expectedCmd := []interface{}{"GRAPH.CONFIG", "GET", "*"}
+	if !reflect.DeepEqual(client.commands[0], expectedCmd) {
+		t.Errorf("Unexpected command: %v", client.commands[0])
+	}
+}
+
+func TestConfigSet(t *testing.T) {
+	client := &fakeClient{}
+	db := &FalkorDB{client: client, opts: &Options{}}
+	ctx := context.Background()
+
+	valid := []struct {
+		key      string
+		value    interface{}
+		expected interface{}
+	}{
+		{"RESULTSET_SIZE", 10000, "10000"},
+		{"resultset_size", int64(-1), "-1"},
+		{"TIMEOUT_DEFAULT", 2 * time.Second, "2000"},
+		{"TIMEOUT_MAX", uint(500), "500"},
+		{"CMD_INFO", false, "no"},
+		{"IMPORT_FOLDER", "/tmp/", "/tmp/"},
+		{"FUTURE_SETTING", 1.5, 1.5},
+	}
+	for _, tt := range valid {
+		client.commands = nil
+		if err := db.ConfigSet(ctx, tt.key, tt.value); err != nil {
+			t.Errorf("ConfigSet(%s, %v) failed: %v", tt.key, tt.value, err)
+			continue
+		}
+		expectedCmd := []interface{}{"GRAPH.CONFIG", "SET", tt.key, tt.expected}
+		if !reflect.DeepEqual(client.commands[0], expectedCmd) {
+			t.Errorf("ConfigSet(%s, %v) sent %v", tt.key, tt.value, client.commands[0])
+		}
+	}
+
+	invalid := []struct {
+		key   string
+		value interface{}
+	}{
+		{"RESULTSET_SIZE", "lots"},
+		{"QUERY_MEM_CAPACITY", 1.5},
+		{"TIMEOUT_DEFAULT", 1500 * time.Microsecond},
+		{"CMD_INFO", 1},
+		{"IMPORT_FOLDER", 42},
+	}
+	for _, tt := range invalid {
+		client.commands = nil
+		err := db.ConfigSet(ctx, tt.key, tt.value)
+		if !errors.Is(err, ErrInvalidConfig) {
+			t.Errorf("ConfigSet(%s, %v) error = %v, expected ErrInvalidConfig", tt.key, tt.value, err)
+		}
+		if len(client.commands) != 0 {
+			t.Errorf("ConfigSet(%s, %v) sent a command", tt.key, tt.value)
+		}
+	}
+}
+
+func TestDiffConfig(t *testing.T) {
+	a := &Config{ThreadCount: 8, CacheSize: 25, Timeout: time.Second, Other: map[string]interface{}{"X": int64(1)}}
+	b := &Config{ThreadCount: 4, CacheSize: 25, Timeout: time.Second, Other: map[string]interface{}{"Y": int64(2)}}
+
+	diffs := DiffConfig(a, b)
+	expected := []ConfigDiff{
+		{Key: "THREAD_COUNT", A: int64(8), B: int64(4)},
+		{Key: "X", A: int64(1), B: nil},
+		{Key: "Y", A: nil, B: int64(2)},
+	}
+	if !reflect.DeepEqual(diffs, expected) {
+		t.Errorf("DiffConfig = %+v, expected %+v", diffs, expected)
+	}
+
+	if diffs := DiffConfig(a, a); len(diffs) != 0 {
+		t.Errorf("Expected no differences, got %+v", diffs)
+	}
+}
+
+func TestConfigServerErrors(t *testing.T) {
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		return nil, serverError("WRONGTYPE Operation against a key holding the wrong kind of value")
+	}}
+	db := &FalkorDB{client: client, opts: &Options{}}
+	ctx := context.Background()
+
+	_, getErr := db.ConfigGet(ctx, "TIMEOUT")
+	setErr := db.ConfigSet(ctx, "TIMEOUT", 10)
+	_, getAllErr := db.ConfigGetAll(ctx)
+	for name, err := range map[string]error{"ConfigGet": getErr, "ConfigSet": setErr, "ConfigGetAll": getAllErr} {
+		var fErr *Error
+		if !errors.As(err, &fErr) || !errors.Is(err, ErrWrongType) {
+			t.Errorf("%s error = %v, expected *Error matching ErrWrongType", name, err)
+		}
+	}
+}
+
+func TestDiffConfigMissingSettings(t *testing.T) {
+	older, err := parseConfig([]interface{}{
+		[]interface{}{"THREAD_COUNT", int64(8)},
+	})
+	if err != nil {
+		t.Fatalf("parseConfig failed: %v", err)
+	}
+	newer, err := parseConfig([]interface{}{
+		[]interface{}{"THREAD_COUNT", int64(8)},
+		[]interface{}{"BOLT_PORT", int64(-1)},
+	})
+	if err != nil {
+		t.Fatalf("parseConfig failed: %v", err)
+	}
+
+	diffs := DiffConfig(older, newer)
+	expected := []ConfigDiff{{Key: "BOLT_PORT", A: nil, B: int64(-1)}}
+	if !reflect.DeepEqual(diffs, expected) {
+		t.Errorf("DiffConfig = %+v, expected %+v", diffs, expected)
+	}
+}
@@ -0,0 +1,243 @@
+// Package cypher provides a fluent builder for Cypher queries.
+//
+// The builder produces a query string and a parameter map that plug straight
+// into Graph.Query. Go values are always sent as parameters, and labels,
+// variables and property names are quoted when needed, so untrusted input
+// cannot alter the structure of the query:
+//
+//	query, params, err := cypher.New().
+//		Match(cypher.Node("p", "Person").Props(map[string]interface{}{"name": name})).
+//		Where(cypher.Prop("p", "age").Gte(18)).
+//		Return(cypher.Prop("p", "email")).
+//		Limit(10).
+//		Build()
+//	if err != nil {
+//		return err
+//	}
+//	result, err := graph.ROQuery(ctx, query, falkordb.WithParams(params))
+//
+// Parameters are named p0, p1, ... in query order, so the same builder chain
+// always yields the same query text and reuses the server's plan cache.
+package cypher
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Builder assembles a Cypher query clause by clause.
+// Clause methods append to the query and return the receiver.
+type Builder struct {
+	clauses []func(w *writer)
+}
+
+// New returns an empty query builder.
+func New() *Builder {
+	return &Builder{}
+}
+
+// Match adds a MATCH clause.
+func (b *Builder) Match(patterns ...*Pattern) *Builder {
+	return b.patterns("MATCH ", patterns)
+}
+
+// OptionalMatch adds an OPTIONAL MATCH clause.
+func (b *Builder) OptionalMatch(patterns ...*Pattern) *Builder {
+	return b.patterns("OPTIONAL MATCH ", patterns)
+}
+
+// Create adds a CREATE clause.
+func (b *Builder) Create(patterns ...*Pattern) *Builder {
+	return b.patterns("CREATE ", patterns)
+}
+
+// Merge adds a MERGE clause.
+func (b *Builder) Merge(pattern *Pattern) *Builder {
+	return b.patterns("MERGE ", []*Pattern{pattern})
+}
+
+// OnCreateSet adds an ON CREATE SET clause to a preceding MERGE.
+func (b *Builder) OnCreateSet(assignments ...Assignment) *Builder {
+	return b.assignments("ON CREATE SET ", assignments)
+}
+
+// OnMatchSet adds an ON MATCH SET clause to a preceding MERGE.
+func (b *Builder) OnMatchSet(assignments ...Assignment) *Builder {
+	return b.assignments("ON MATCH SET ", assignments)
+}
+
+// Where adds a WHERE clause.
+func (b *Builder) Where(cond Expr) *Builder {
+	return b.exprs("WHERE ", []Expr{cond})
+}
+
+// With adds a WITH clause.
+func (b *Builder) With(items ...Expr) *Builder {
+	return b.exprs("WITH ", items)
+}
+
+// Return adds a RETURN clause.
+func (b *Builder) Return(items ...Expr) *Builder {
+	return b.exprs("RETURN ", items)
+}
+
+// ReturnDistinct adds a RETURN DISTINCT clause.
+func (b *Builder) ReturnDistinct(items ...Expr) *Builder {
+	return b.exprs("RETURN DISTINCT ", items)
+}
+
+// OrderBy adds an ORDER BY clause. Use Expr.Desc for descending order.
+func (b *Builder) OrderBy(items ...Expr) *Builder {
+	return b.exprs("ORDER BY ", items)
+}
+
+// Skip adds a SKIP clause.
+func (b *Builder) Skip(n int64) *Builder {
+	return b.exprs("SKIP ", []Expr{Lit(n)})
+}
+
+// Limit adds a LIMIT clause.
+func (b *Builder) Limit(n int64) *Builder {
+	return b.exprs("LIMIT ", []Expr{Lit(n)})
+}
+
+// Set adds a SET clause.
+func (b *Builder) Set(assignments ...Assignment) *Builder {
+	return b.assignments("SET ", assignments)
+}
+
+// Remove adds a REMOVE clause for properties.
+func (b *Builder) Remove(props ...Expr) *Builder {
+	return b.exprs("REMOVE ", props)
+}
+
+// Delete adds a DELETE clause.
+func (b *Builder) Delete(items ...Expr) *Builder {
+	return b.exprs("DELETE ", items)
+}
+
+// DetachDelete adds a DETACH DELETE clause.
+func (b *Builder) DetachDelete(items ...Expr) *Builder {
+	return b.exprs("DETACH DELETE ", items)
+}
+
+// Unwind adds an UNWIND clause. A list that is not an expression is sent as a parameter.
+func (b *Builder) Unwind(list interface{}, alias string) *Builder {
+	return b.add(func(w *writer) {
+		w.b.WriteString("UNWIND ")
+		w.operand(list)
+		w.b.WriteString(" AS ")
+		w.ident(alias)
+	})
+}
+
+// Call adds a CALL clause for a procedure. Arguments that are not
+// expressions are sent as parameters.
+func (b *Builder) Call(procedure string, args ...interface{}) *Builder {
+	return b.add(func(w *writer) {
+		w.b.WriteString("CALL ")
+		w.qualifiedName(procedure)
+		w.b.WriteByte('(')
+		w.list(args)
+		w.b.WriteByte(')')
+	})
+}
+
+// Yield adds a YIELD clause to a preceding CALL.
+func (b *Builder) Yield(items ...Expr) *Builder {
+	return b.exprs("YIELD ", items)
+}
+
+// Build returns the query text and the parameters it references.
+func (b *Builder) Build() (string, map[string]interface{}, error) {
+	w := &writer{params: make(map[string]interface{})}
+	for i, clause := range b.clauses {
+		if i > 0 {
+			w.b.WriteByte(' ')
+		}
+		clause(w)
+	}
+	names := make([]string, 0, len(w.named))
+	for name := range w.named {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := w.params[name]; ok {
+			w.fail(fmt.Errorf("parameter $%s collides with a generated parameter name", name))
+		}
+	}
+	if w.err != nil {
+		return "", nil, w.err
+	}
+	return w.b.String(), w.params, nil
+}
+
+// Assignment is a SET item created by Assign or AssignAll.
+type Assignment struct {
+	target Expr
+	op     string
+	value  interface{}
+}
+
+// Assign sets a property: Assign(Prop("n", "age"), 31) is n.age = $p0.
+func Assign(target Expr, value interface{}) Assignment {
+	return Assignment{target: target, op: " = ", value: value}
+}
+
+// AssignAll adds properties from a map: AssignAll("n", props) is n += $p0.
+func AssignAll(variable string, props map[string]interface{}) Assignment {
+	return Assignment{target: Var(variable), op: " += ", value: props}
+}
+
+func (b *Builder) add(clause func(w *writer)) *Builder {
+	b.clauses = append(b.clauses, clause)
+	return b
+}
+
+func (b *Builder) patterns(keyword string, patterns []*Pattern) *Builder {
+	return b.add(func(w *writer) {
+		w.b.WriteString(keyword)
+		for i, p := range patterns {
+			if i > 0 {
+				w.b.WriteString(", ")
+			}
+			p.writeTo(w)
+		}
+	})
+}
+
+func (b *Builder) exprs(keyword string, items []Expr) *Builder {
+	return b.add(func(w *writer) {
+		w.b.WriteString(keyword)
+		for i, item := range items {
+			if i > 0 {
+				w.b.WriteString(", ")
+			}
+			w.expr(item)
+		}
+	})
+}
+
+func (b *Builder) assignments(keyword string, assignments []Assignment) *Builder {
+	return b.add(func(w *writer) {
+		w.b.WriteString(keyword)
+		for i, a := range assignments {
+			if i > 0 {
+				w.b.WriteString(", ")
+			}
+			w.expr(a.target)
+			w.b.WriteString(a.op)
+			w.operand(a.value)
+		}
+	})
+}
+
+// String returns the query text, or an empty string if it cannot be built.
+func (b *Builder) String() string {
+	query, _, err := b.Build()
+	if err != nil {
+		return ""
+	}
+	return query
+}
@@ -0,0 +1,171 @@
+package cypher
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		name   string
+		b      *Builder
+		query  string
+		params map[string]interface{}
+	}{
+		{
+			name: "match where return",
+			b: New().
+				Match(Node("p", "Person").Props(map[string]interface{}{"name": "Alice"})).
+				Where(And(Prop("p", "age").Gte(18), Prop("p", "email").IsNotNull())).
+				Return(Prop("p", "name").As("name")).
+				OrderBy(Prop("p", "age").Desc()).
+				Skip(5).
+				Limit(10),
+			query:  "MATCH (p:Person {name: $p0}) WHERE (p.age >= $p1 AND p.email IS NOT NULL) RETURN p.name AS name ORDER BY p.age DESC SKIP $p2 LIMIT $p3",
+			params: map[string]interface{}{"p0": "Alice", "p1": 18, "p2": int64(5), "p3": int64(10)},
+		},
+		{
+			name: "relationships",
+			b: New().
+				Match(Path("path", Node("a").Out(Rel("r", "KNOWS", "LIKES").Hops(1, 3), Node("b", "Person")).In(nil, Node("c")))).
+				OptionalMatch(Node("a").Related(Rel("", "WORKS_AT").Props(map[string]interface{}{"since": 2020}), Node("co", "Company"))).
+				ReturnDistinct(Var("b"), Var("co")),
+			query:  "MATCH path = (a)-[r:KNOWS|LIKES*1..3]->(b:Person)<-[]-(c) OPTIONAL MATCH (a)-[:WORKS_AT {since: $p0}]-(co:Company) RETURN DISTINCT b, co",
+			params: map[string]interface{}{"p0": 2020},
+		},
+		{
+			name: "hops with properties",
+			b: New().
+				Match(Node("a").Out(Rel("r", "KNOWS").Props(map[string]interface{}{"since": 2020}).Hops(1, 3), Node("b"))).
+				Match(Node("a").Out(Rel("").Hops(-1, -1).Props(map[string]interface{}{"w": 1}), Node("c"))).
+				Return(Var("b")),
+			query:  "MATCH (a)-[r:KNOWS*1..3 {since: $p0}]->(b) MATCH (a)-[* {w: $p1}]->(c) RETURN b",
+			params: map[string]interface{}{"p0": 2020, "p1": 1},
+		},
+		{
+			name: "merge",
+			b: New().
+				Merge(Node("p", "Person").Props(map[string]interface{}{"id": 7})).
+				OnCreateSet(Assign(Prop("p", "created"), Fn("timestamp"))).
+				OnMatchSet(AssignAll("p", map[string]interface{}{"seen": true})).
+				Return(Var("p")),
+			query:  "MERGE (p:Person {id: $p0}) ON CREATE SET p.created = timestamp() ON MATCH SET p += $p1 RETURN p",
+			params: map[string]interface{}{"p0": 7, "p1": map[string]interface{}{"seen": true}},
+		},
+		{
+			name: "unwind create",
+			b: New().
+				Unwind([]string{"a", "b"}, "name").
+				Create(Node("n", "Tag").Props(map[string]interface{}{"name": Var("name")})),
+			query:  "UNWIND $p0 AS name CREATE (n:Tag {name: name})",
+			params: map[string]interface{}{"p0": []string{"a", "b"}},
+		},
+		{
+			name: "set remove delete",
+			b: New().
+				Match(Node("n", "Temp")).
+				With(Var("n"), Fn("count", Var("n")).As("c")).
+				Where(Or(Var("c").Gt(1), Not(Prop("n", "keep").Eq(true)))).
+				Set(Assign(Prop("n", "flag"), false)).
+				Remove(Prop("n", "old")).
+				DetachDelete(Var("n")),
+			query:  "MATCH (n:Temp) WITH n, count(n) AS c WHERE (c > $p0 OR NOT (n.keep = $p1)) SET n.flag = $p2 REMOVE n.old DETACH DELETE n",
+			params: map[string]interface{}{"p0": 1, "p1": true, "p2": false},
+		},
+		{
+			name: "call yield",
+			b: New().
+				Call("db.idx.fulltext.queryNodes", "Movie", Param("term")).
+				Yield(Var("node"), Var("score")).
+				Return(Prop("node", "title")),
+			query:  "CALL db.idx.fulltext.queryNodes($p0, $term) YIELD node, score RETURN node.title",
+			params: map[string]interface{}{"p0": "Movie"},
+		},
+		{
+			name: "quoted identifiers",
+			b: New().
+				Match(Node("n", "My Label").Props(map[string]interface{}{"first name": "x"})).
+				Return(Prop("n", "we`ird")),
+			query:  "MATCH (n:`My Label` {`first name`: $p0}) RETURN n.`we``ird`",
+			params: map[string]interface{}{"p0": "x"},
+		},
+		{
+			name: "reserved words",
+			b: New().
+				Match(Node("match", "Order")).
+				Where(Fn("exists", Prop("match", "end"))).
+				Return(Var("Match"), Fn("count", Var("match")).As("limit")),
+			query:  "MATCH (`match`:`Order`) WHERE exists(`match`.`end`) RETURN `Match`, count(`match`) AS `limit`",
+			params: map[string]interface{}{},
+		},
+		{
+			name: "string predicates",
+			b: New().
+				Match(Node("n")).
+				Where(And(Prop("n", "name").StartsWith("A"), Prop("n", "name").Contains("li"), Prop("n", "tag").In([]string{"x"}))).
+				Return(Var("n")),
+			query:  "MATCH (n) WHERE (n.name STARTS WITH $p0 AND n.name CONTAINS $p1 AND n.tag IN $p2) RETURN n",
+			params: map[string]interface{}{"p0": "A", "p1": "li", "p2": []string{"x"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, params, err := tt.b.Build()
+			if err != nil {
+				t.Fatalf("Build failed: %v", err)
+			}
+			if query != tt.query {
+				t.Errorf("query:\n got %s\nwant %s", query, tt.query)
+			}
+			if !reflect.DeepEqual(params, tt.params) {
+				t.Errorf("params: got %v, want %v", params, tt.params)
+			}
+		})
+	}
+}
+
+func TestHops(t *testing.T) {
+	tests := []struct {
+		min, max int
+		want     string
+	}{
+		{-1, -1, "*"},
+		{2, 2, "*2"},
+		{1, -1, "*1.."},
+		{-1, 4, "*..4"},
+		{0, 3, "*0..3"},
+	}
+
+	for _, tt := range tests {
+		query := New().Match(Node("a").Out(Rel("").Hops(tt.min, tt.max), Node("b"))).String()
+		want := "MATCH (a)-[" + tt.want + "]->(b)"
+		if query != want {
+			t.Errorf("Hops(%d, %d) = %s, want %s", tt.min, tt.max, query, want)
+		}
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		b    *Builder
+		want string
+	}{
+		{"zero expr", New().Return(Expr{}), "empty expression"},
+		{"empty and", New().Match(Node("n")).Where(And()), "empty condition list"},
+		{"nul identifier", New().Match(Node("n", "bad\x00label")), "identifier"},
+		{"param collision", New().Match(Node("n").Props(map[string]interface{}{"a": 1})).Where(Param("p0").Eq(Prop("n", "a"))), "$p0 collides"},
+		{"late param collision", New().Match(Node("n")).Where(Param("p1").Eq(Prop("n", "a"))).Return(Lit(1), Lit(2)), "$p1 collides"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := tt.b.Build()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
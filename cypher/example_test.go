@@ -0,0 +1,26 @@
+package cypher_test
+
+import (
+	"fmt"
+
+	"github.com/flancast90/falkordb-go/cypher"
+)
+
+func Example() {
+	query, params, err := cypher.New().
+		Match(cypher.Node("p", "Person").Out(cypher.Rel("", "KNOWS"), cypher.Node("f", "Person"))).
+		Where(cypher.Prop("p", "name").Eq("Alice")).
+		Return(cypher.Prop("f", "name").As("friend")).
+		OrderBy(cypher.Prop("f", "name")).
+		Limit(10).
+		Build()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(query)
+	fmt.Println(params)
+	// Output:
+	// MATCH (p:Person)-[:KNOWS]->(f:Person) WHERE p.name = $p0 RETURN f.name AS friend ORDER BY f.name LIMIT $p1
+	// map[p0:Alice p1:10]
+}
@@ -0,0 +1,264 @@
+package cypher
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+)
+
+// Expr is a Cypher expression. Build expressions with Var, Prop, Lit, Fn and
+// friends, and combine them with the comparison methods, And, Or and Not.
+//
+// Go values passed where an expression is expected are sent as query
+// parameters, never interpolated into the query text.
+type Expr struct {
+	write func(w *writer)
+}
+
+// Var references a variable bound in a pattern or by WITH, UNWIND or YIELD.
+func Var(name string) Expr {
+	return Expr{func(w *writer) { w.ident(name) }}
+}
+
+// Prop references a property of a variable: Prop("n", "name") is n.name.
+func Prop(variable, key string) Expr {
+	return Var(variable).Prop(key)
+}
+
+// Param references a named parameter, such as one supplied separately with
+// falkordb.WithParam. Literal values should use Lit instead.
+//
+// Values sent by the builder are named p0, p1 and so on; Build fails if a
+// Param name is also used for one of them.
+func Param(name string) Expr {
+	return Expr{func(w *writer) {
+		if w.named == nil {
+			w.named = make(map[string]bool)
+		}
+		w.named[name] = true
+		w.b.WriteByte('$')
+		w.ident(name)
+	}}
+}
+
+// Lit sends a Go value as a query parameter.
+func Lit(value interface{}) Expr {
+	return Expr{func(w *writer) { w.param(value) }}
+}
+
+// Raw inserts text into the query verbatim. It is an escape hatch for
+// syntax the builder does not cover and must never contain user input.
+func Raw(text string) Expr {
+	return Expr{func(w *writer) { w.b.WriteString(text) }}
+}
+
+// Fn calls a function: Fn("count", Var("n")) is count(n).
+// Arguments that are not expressions are sent as parameters.
+func Fn(name string, args ...interface{}) Expr {
+	return Expr{func(w *writer) {
+		w.qualifiedName(name)
+		w.b.WriteByte('(')
+		w.list(args)
+		w.b.WriteByte(')')
+	}}
+}
+
+// Prop references a property of the expression.
+func (e Expr) Prop(key string) Expr {
+	return Expr{func(w *writer) {
+		w.expr(e)
+		w.b.WriteByte('.')
+		w.ident(key)
+	}}
+}
+
+// As aliases the expression: Fn("count", Var("n")).As("c") is count(n) AS c.
+func (e Expr) As(alias string) Expr {
+	return Expr{func(w *writer) {
+		w.expr(e)
+		w.b.WriteString(" AS ")
+		w.ident(alias)
+	}}
+}
+
+// Asc orders by the expression in ascending order.
+func (e Expr) Asc() Expr { return e.suffix(" ASC") }
+
+// Desc orders by the expression in descending order.
+func (e Expr) Desc() Expr { return e.suffix(" DESC") }
+
+// IsNull tests the expression for null.
+func (e Expr) IsNull() Expr { return e.suffix(" IS NULL") }
+
+// IsNotNull tests the expression for a non-null value.
+func (e Expr) IsNotNull() Expr { return e.suffix(" IS NOT NULL") }
+
+// Eq compares the expression for equality.
+func (e Expr) Eq(v interface{}) Expr { return e.binary("=", v) }
+
+// Ne compares the expression for inequality.
+func (e Expr) Ne(v interface{}) Expr { return e.binary("<>", v) }
+
+// Gt tests whether the expression is greater than v.
+func (e Expr) Gt(v interface{}) Expr { return e.binary(">", v) }
+
+// Gte tests whether the expression is greater than or equal to v.
+func (e Expr) Gte(v interface{}) Expr { return e.binary(">=", v) }
+
+// Lt tests whether the expression is less than v.
+func (e Expr) Lt(v interface{}) Expr { return e.binary("<", v) }
+
+// Lte tests whether the expression is less than or equal to v.
+func (e Expr) Lte(v interface{}) Expr { return e.binary("<=", v) }
+
+// In tests whether the expression is an element of the list v.
+func (e Expr) In(v interface{}) Expr { return e.binary("IN", v) }
+
+// Contains tests whether the string expression contains v.
+func (e Expr) Contains(v interface{}) Expr { return e.binary("CONTAINS", v) }
+
+// StartsWith tests whether the string expression starts with v.
+func (e Expr) StartsWith(v interface{}) Expr { return e.binary("STARTS WITH", v) }
+
+// EndsWith tests whether the string expression ends with v.
+func (e Expr) EndsWith(v interface{}) Expr { return e.binary("ENDS WITH", v) }
+
+// And combines conditions with AND.
+func And(conds ...Expr) Expr { return join(" AND ", conds) }
+
+// Or combines conditions with OR.
+func Or(conds ...Expr) Expr { return join(" OR ", conds) }
+
+// Not negates a condition.
+func Not(cond Expr) Expr {
+	return Expr{func(w *writer) {
+		w.b.WriteString("NOT (")
+		w.expr(cond)
+		w.b.WriteByte(')')
+	}}
+}
+
+func (e Expr) binary(op string, v interface{}) Expr {
+	return Expr{func(w *writer) {
+		w.expr(e)
+		w.b.WriteString(" " + op + " ")
+		w.operand(v)
+	}}
+}
+
+func (e Expr) suffix(s string) Expr {
+	return Expr{func(w *writer) {
+		w.expr(e)
+		w.b.WriteString(s)
+	}}
+}
+
+func join(sep string, conds []Expr) Expr {
+	return Expr{func(w *writer) {
+		if len(conds) == 0 {
+			w.fail(fmt.Errorf("empty condition list"))
+			return
+		}
+		w.b.WriteByte('(')
+		for i, c := range conds {
+			if i > 0 {
+				w.b.WriteString(sep)
+			}
+			w.expr(c)
+		}
+		w.b.WriteByte(')')
+	}}
+}
+
+// writer accumulates query text and the parameters it references.
+type writer struct {
+	b      strings.Builder
+	params map[string]interface{}
+	err    error
+
+	// named holds the names referenced with Param.
+	named map[string]bool
+}
+
+func (w *writer) fail(err error) {
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+// expr writes an expression, failing on the zero Expr.
+func (w *writer) expr(e Expr) {
+	if e.write == nil {
+		w.fail(fmt.Errorf("empty expression"))
+		return
+	}
+	e.write(w)
+}
+
+// operand writes v as an expression if it is one, otherwise as a parameter.
+func (w *writer) operand(v interface{}) {
+	switch e := v.(type) {
+	case Expr:
+		w.expr(e)
+	case *Pattern:
+		e.writeTo(w)
+	default:
+		w.param(v)
+	}
+}
+
+// list writes comma-separated operands.
+func (w *writer) list(items []interface{}) {
+	for i, item := range items {
+		if i > 0 {
+			w.b.WriteString(", ")
+		}
+		w.operand(item)
+	}
+}
+
+// param registers v under the next generated parameter name and writes a
+// reference to it. Names are assigned in query order, so a builder chain
+// always produces the same query text.
+func (w *writer) param(v interface{}) {
+	name := fmt.Sprintf("p%d", len(w.params))
+	w.params[name] = v
+	w.b.WriteString("$" + name)
+}
+
+// ident writes a variable, label or property name, quoting it if it is
+// not a plain identifier or is a reserved word.
+func (w *writer) ident(name string) {
+	if proto.IsIdentifier(name) && !proto.IsReservedWord(name) {
+		w.b.WriteString(name)
+		return
+	}
+	w.quoted(name)
+}
+
+// quoted writes name backtick-quoted.
+func (w *writer) quoted(name string) {
+	quoted, err := proto.QuoteIdentifier(name)
+	if err != nil {
+		w.fail(fmt.Errorf("identifier %q: %w", name, err))
+		return
+	}
+	w.b.WriteString(quoted)
+}
+
+// qualifiedName writes a dotted function or procedure name such as
+// db.labels. Function names such as exists are keywords too, so parts are
+// only quoted if they are not plain identifiers.
+func (w *writer) qualifiedName(name string) {
+	for i, part := range strings.Split(name, ".") {
+		if i > 0 {
+			w.b.WriteByte('.')
+		}
+		if proto.IsIdentifier(part) {
+			w.b.WriteString(part)
+		} else {
+			w.quoted(part)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package cypher
+
+import (
+	"sort"
+	"strconv"
+)
+
+// direction of a relationship in a pattern.
+type direction int
+
+const (
+	dirOut direction = iota
+	dirIn
+	dirBoth
+)
+
+// Pattern is a graph pattern such as (a:Person)-[:KNOWS]->(b), used by
+// Match, Create and Merge. Pattern methods modify and return the receiver.
+type Pattern struct {
+	path  string
+	nodes []*element
+	rels  []*Relationship
+}
+
+// element is a node in a pattern.
+type element struct {
+	variable string
+	labels   []string
+	props    map[string]interface{}
+}
+
+// Relationship describes the relationship between two nodes of a pattern.
+type Relationship struct {
+	element
+	dir       direction
+	minHops   int
+	maxHops   int
+	varLength bool
+}
+
+// Node starts a pattern with a single node. The variable may be empty.
+func Node(variable string, labels ...string) *Pattern {
+	return &Pattern{nodes: []*element{{variable: variable, labels: labels}}}
+}
+
+// Path names the whole pattern: Path("p", pattern) is p = pattern.
+func Path(name string, pattern *Pattern) *Pattern {
+	pattern.path = name
+	return pattern
+}
+
+// Rel describes a relationship with an optional variable and types.
+// Multiple types match any of them.
+func Rel(variable string, types ...string) *Relationship {
+	return &Relationship{element: element{variable: variable, labels: types}}
+}
+
+// Props sets properties on the relationship. Values are sent as parameters.
+func (r *Relationship) Props(props map[string]interface{}) *Relationship {
+	r.props = props
+	return r
+}
+
+// Hops makes the relationship variable-length. A negative bound is left
+// open, so Hops(1, -1) is *1.. and Hops(-1, -1) is *.
+func (r *Relationship) Hops(min, max int) *Relationship {
+	r.varLength = true
+	r.minHops = min
+	r.maxHops = max
+	return r
+}
+
+// Props sets properties on the last node of the pattern.
+// Values are sent as parameters.
+func (p *Pattern) Props(props map[string]interface{}) *Pattern {
+	p.nodes[len(p.nodes)-1].props = props
+	return p
+}
+
+// Out extends the pattern with an outgoing relationship: (a)-[rel]->(to).
+func (p *Pattern) Out(rel *Relationship, to *Pattern) *Pattern {
+	return p.extend(rel, dirOut, to)
+}
+
+// In extends the pattern with an incoming relationship: (a)<-[rel]-(from).
+func (p *Pattern) In(rel *Relationship, from *Pattern) *Pattern {
+	return p.extend(rel, dirIn, from)
+}
+
+// Related extends the pattern with an undirected relationship: (a)-[rel]-(other).
+func (p *Pattern) Related(rel *Relationship, other *Pattern) *Pattern {
+	return p.extend(rel, dirBoth, other)
+}
+
+func (p *Pattern) extend(rel *Relationship, dir direction, next *Pattern) *Pattern {
+	if rel == nil {
+		rel = Rel("")
+	}
+	rel.dir = dir
+	p.rels = append(p.rels, rel)
+	p.nodes = append(p.nodes, next.nodes[0])
+	p.rels = append(p.rels, next.rels...)
+	p.nodes = append(p.nodes, next.nodes[1:]...)
+	return p
+}
+
+func (p *Pattern) writeTo(w *writer) {
+	if p.path != "" {
+		w.ident(p.path)
+		w.b.WriteString(" = ")
+	}
+	for i, node := range p.nodes {
+		if i > 0 {
+			p.rels[i-1].writeTo(w)
+		}
+		w.b.WriteByte('(')
+		node.writeTo(w, ":")
+		w.b.WriteByte(')')
+	}
+}
+
+func (r *Relationship) writeTo(w *writer) {
+	if r.dir == dirIn {
+		w.b.WriteString("<-[")
+	} else {
+		w.b.WriteString("-[")
+	}
+
+	// The hop range goes between the types and the properties
+	r.element.writeName(w, "|")
+	if r.varLength {
+		w.b.WriteByte('*')
+		if r.minHops >= 0 {
+			w.b.WriteString(strconv.Itoa(r.minHops))
+		}
+		if r.minHops != r.maxHops && (r.minHops >= 0 || r.maxHops >= 0) {
+			w.b.WriteString("..")
+			if r.maxHops >= 0 {
+				w.b.WriteString(strconv.Itoa(r.maxHops))
+			}
+		}
+	}
+	r.element.writeProps(w, r.variable != "" || len(r.labels) > 0 || r.varLength)
+
+	if r.dir == dirOut {
+		w.b.WriteString("]->")
+	} else {
+		w.b.WriteString("]-")
+	}
+}
+
+// writeTo writes the variable, labels joined by sep, and properties.
+func (e *element) writeTo(w *writer, sep string) {
+	e.writeName(w, sep)
+	e.writeProps(w, e.variable != "" || len(e.labels) > 0)
+}
+
+// writeName writes the variable and labels joined by sep.
+func (e *element) writeName(w *writer, sep string) {
+	if e.variable != "" {
+		w.ident(e.variable)
+	}
+	for i, label := range e.labels {
+		if i == 0 {
+			w.b.WriteByte(':')
+		} else {
+			w.b.WriteString(sep)
+		}
+		w.ident(label)
+	}
+}
+
+// writeProps writes the properties, if any, after a space when space is set.
+func (e *element) writeProps(w *writer, space bool) {
+	if len(e.props) > 0 {
+		if space {
+			w.b.WriteByte(' ')
+		}
+		writeProps(w, e.props)
+	}
+}
+
+// writeProps writes a property map in key order, sending values as parameters.
+func writeProps(w *writer, props map[string]interface{}) {
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	w.b.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			w.b.WriteString(", ")
+		}
+		w.ident(k)
+		w.b.WriteString(": ")
+		w.operand(props[k])
+	}
+	w.b.WriteByte('}')
+}
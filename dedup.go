@@ -0,0 +1,133 @@
+package falkordb
+
+import (
+	"context"
+	"sync"
+)
+
+// flightGroup collapses concurrent identical read queries into a single
+// server call. The zero value is ready to use.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[flightKey]*flight
+
+	// writes counts the writes made through this client to each graph.
+	writes map[string]uint64
+}
+
+// flightKey identifies a shared call by its query and by the generations
+// of its graph when it started: writes counts writes made through this
+// client, and cacheGen also counts invalidations announced by other
+// clients. A read that begins after either never joins, and then caches,
+// a call that began before it.
+type flightKey struct {
+	cacheKey
+	writes   uint64
+	cacheGen uint64
+}
+
+// generation returns the number of writes made to graph so far.
+func (fg *flightGroup) generation(graph string) uint64 {
+	fg.mu.Lock()
+	defer fg.mu.Unlock()
+	return fg.writes[graph]
+}
+
+// invalidate records a write to graph, so later reads start new calls.
+func (fg *flightGroup) invalidate(graph string) {
+	fg.mu.Lock()
+	defer fg.mu.Unlock()
+	if fg.writes == nil {
+		fg.writes = make(map[string]uint64)
+	}
+	fg.writes[graph]++
+}
+
+// flight is a server call shared by one or more waiting queries.
+type flight struct {
+	done    chan struct{}
+	result  *QueryResult
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// do runs fn once for all concurrent callers with the same key and returns
+// each caller its own copy of the result. The call runs on a context that
+// is only cancelled once every caller has given up, so one caller's
+// cancellation does not fail the others.
+func (fg *flightGroup) do(ctx context.Context, key flightKey, fn func(ctx context.Context) (*QueryResult, error)) (*QueryResult, error) {
+	fg.mu.Lock()
+	if fg.flights == nil {
+		fg.flights = make(map[flightKey]*flight)
+	}
+	f, ok := fg.flights[key]
+	if !ok {
+		callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		fg.flights[key] = f
+		go fg.run(callCtx, key, f, fn)
+	}
+	f.waiters++
+	fg.mu.Unlock()
+
+	select {
+	case <-f.done:
+		if f.err != nil {
+			return nil, f.err
+		}
+		return f.result.clone(), nil
+	case <-ctx.Done():
+		fg.mu.Lock()
+		f.waiters--
+		if f.waiters == 0 {
+			f.cancel()
+			fg.forget(key, f)
+		}
+		fg.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+func (fg *flightGroup) run(ctx context.Context, key flightKey, f *flight, fn func(ctx context.Context) (*QueryResult, error)) {
+	f.result, f.err = fn(ctx)
+	f.cancel()
+
+	fg.mu.Lock()
+	fg.forget(key, f)
+	fg.mu.Unlock()
+	close(f.done)
+}
+
+// forget removes f so later callers start a new call. fg.mu must be held.
+func (fg *flightGroup) forget(key flightKey, f *flight) {
+	if fg.flights[key] == f {
+		delete(fg.flights, key)
+	}
+}
+
+// clone returns a copy of r whose rows, headers and metadata can be modified
+// without affecting r. Nodes, edges and other values in the rows are shared.
+func (r *QueryResult) clone() *QueryResult {
+	c := &QueryResult{
+		Headers:  append([]Header(nil), r.Headers...),
+		Metadata: append([]string(nil), r.Metadata...),
+		Stats:    r.Stats,
+	}
+	if r.Data != nil {
+		c.Data = make([]map[string]interface{}, len(r.Data))
+		for i, row := range r.Data {
+			c.Data[i] = make(map[string]interface{}, len(row))
+			for k, v := range row {
+				c.Data[i][k] = v
+			}
+		}
+	}
+	if r.Stats.Other != nil {
+		c.Stats.Other = make(map[string]string, len(r.Stats.Other))
+		for k, v := range r.Stats.Other {
+			c.Stats.Other[k] = v
+		}
+	}
+	return c
+}
@@ -0,0 +1,212 @@
+package falkordb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFlightGroupShares(t *testing.T) {
+	var fg flightGroup
+	release := make(chan struct{})
+	calls := 0
+	fn := func(ctx context.Context) (*QueryResult, error) {
+		calls++
+		<-release
+		return &QueryResult{Data: []map[string]interface{}{{"n": int64(1)}}}, nil
+	}
+
+	const waiters = 10
+	results := make([]*QueryResult, waiters)
+	var wg sync.WaitGroup
+	for i := 0; i < waiters; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res, err := fg.do(context.Background(), flightKey{cacheKey: cacheKey{"g", "q"}}, fn)
+			if err != nil {
+				t.Errorf("do failed: %v", err)
+			}
+			results[i] = res
+		}(i)
+	}
+
+	// Wait for every caller to join the flight before releasing it
+	for {
+		fg.mu.Lock()
+		f := fg.flights[flightKey{cacheKey: cacheKey{"g", "q"}}]
+		joined := f != nil && f.waiters == waiters
+		fg.mu.Unlock()
+		if joined {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+	results[0].Data[0]["n"] = "modified"
+	for _, res := range results[1:] {
+		if res == results[0] || res.Data[0]["n"] != int64(1) {
+			t.Fatalf("Callers share a result: %v", res.Data)
+		}
+	}
+	if len(fg.flights) != 0 {
+		t.Errorf("Finished flight was not removed")
+	}
+}
+
+func TestFlightGroupCancel(t *testing.T) {
+	var fg flightGroup
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+	fn := func(ctx context.Context) (*QueryResult, error) {
+		close(started)
+		<-ctx.Done()
+		close(cancelled)
+		return nil, ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := fg.do(ctx, flightKey{cacheKey: cacheKey{"g", "q"}}, fn)
+		done <- err
+	}()
+
+	<-started
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("Shared call was not cancelled after its last waiter left")
+	}
+}
+
+func TestROQueryDedup(t *testing.T) {
+	release := make(chan struct{})
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		if strings.HasPrefix(args[2].(string), "RETURN") {
+			<-release
+		}
+		return []interface{}{[]interface{}{}}, nil
+	}}
+	graph := newTestGraph(client, WithDedup())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := graph.ROQuery(context.Background(), "RETURN 1"); err != nil {
+				t.Errorf("ROQuery failed: %v", err)
+			}
+		}()
+	}
+	for {
+		graph.flights.mu.Lock()
+		f := graph.flights.flights[flightKey{cacheKey: cacheKey{"test", "RETURN 1"}}]
+		joined := f != nil && f.waiters == 5
+		graph.flights.mu.Unlock()
+		if joined {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	sent := 0
+	for _, args := range client.commands {
+		if args[2] == "RETURN 1" {
+			sent++
+		}
+	}
+	if sent != 1 {
+		t.Errorf("Expected 1 query sent, got %d", sent)
+	}
+}
+
+func TestROQueryDedupWriteDuringFlight(t *testing.T) {
+	t.Run("WithCache", func(t *testing.T) { testDedupWriteDuringFlight(t, true) })
+	t.Run("WithoutCache", func(t *testing.T) { testDedupWriteDuringFlight(t, false) })
+}
+
+func testDedupWriteDuringFlight(t *testing.T, withCache bool) {
+	const query = "MATCH (n) RETURN count(n)"
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var mu sync.Mutex
+	calls := 0
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		switch args[2] {
+		case "CREATE ()":
+			return []interface{}{[]interface{}{"Nodes created: 1"}}, nil
+		case query:
+			mu.Lock()
+			calls++
+			call := calls
+			mu.Unlock()
+			if call == 1 {
+				close(started)
+				<-release
+			}
+			return []interface{}{[]interface{}{fmt.Sprintf("Version: %d", call)}}, nil
+		}
+		return []interface{}{[]interface{}{}}, nil
+	}}
+	db := &FalkorDB{client: client, opts: &Options{}}
+	if withCache {
+		db.cache = newResultCache(CacheOptions{})
+	}
+	graph := db.SelectGraph("test", WithDedup())
+	ctx := context.Background()
+
+	read := func(done chan<- *QueryResult) {
+		res, err := graph.ROQuery(ctx, query)
+		if err != nil {
+			t.Errorf("ROQuery failed: %v", err)
+		}
+		done <- res
+	}
+
+	// Reader A starts a flight before the write
+	doneA := make(chan *QueryResult, 1)
+	go read(doneA)
+	<-started
+
+	if _, err := graph.Query(ctx, "CREATE ()"); err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+
+	// Reader B starts after the write and must not join A's flight
+	doneB := make(chan *QueryResult, 1)
+	go read(doneB)
+	select {
+	case res := <-doneB:
+		if res.Stats.Other["Version"] != "2" {
+			t.Errorf("Reader B got the pre-write result: %v", res.Stats.Other)
+		}
+	case <-time.After(time.Second):
+		t.Error("Reader B joined the flight that started before the write")
+	}
+	close(release)
+	<-doneA
+
+	if withCache {
+		cached, _ := db.cache.get(cacheKey{graph: "test", query: query})
+		if cached == nil || cached.Stats.Other["Version"] != "2" {
+			t.Errorf("Expected the post-write result to be cached, got %+v", cached)
+		}
+	}
+}
@@ -54,10 +54,11 @@
 // The [Graph] type provides methods for:
 //
 //   - Executing Cypher queries ([Graph.Query], [Graph.ROQuery])
+//   - Prepared statements ([Graph.Prepare])
 //   - Managing indexes ([Graph.CreateNodeRangeIndex], etc.)
 //   - Managing constraints ([Graph.ConstraintCreate], [Graph.ConstraintDrop])
 //   - Graph operations ([Graph.Copy], [Graph.Delete])
-//   - Query analysis ([Graph.Explain], [Graph.Profile], [Graph.SlowLog])
+//   - Query analysis ([Graph.Explain], [Graph.Profile], [Graph.Lint], [Graph.SlowLog])
 //
 // # Data Types
 //
@@ -67,6 +68,7 @@
 //   - [Edge]: Relationships with type and properties
 //   - [Path]: Paths containing nodes and edges
 //   - [Point]: Geographic coordinates
+//   - [DateTime], [Date], [Time], [Duration]: Temporal values
 //
 // # Thread Safety
 //
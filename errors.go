@@ -0,0 +1,124 @@
+package falkordb
+
+import (
+	"errors"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+	"github.com/flancast90/falkordb-go/internal/redis"
+)
+
+// Sentinel errors for classifying FalkorDB server failures.
+// Use errors.Is to test a returned error against them:
+//
+//	if errors.Is(err, falkordb.ErrSyntax) {
+//		// fix the query
+//	}
+var (
+	// ErrSyntax indicates the query could not be parsed or validated.
+	ErrSyntax = errors.New("falkordb: syntax error")
+
+	// ErrUnknownFunction indicates the query called a function the server does not know.
+	ErrUnknownFunction = errors.New("falkordb: unknown function")
+
+	// ErrQueryTimeout indicates the query exceeded its server-side timeout.
+	ErrQueryTimeout = errors.New("falkordb: query timed out")
+
+	// ErrConstraintViolation indicates a write violated a unique or mandatory constraint.
+	ErrConstraintViolation = errors.New("falkordb: constraint violation")
+
+	// ErrUnknownGraph indicates the graph does not exist.
+	ErrUnknownGraph = errors.New("falkordb: unknown graph")
+
+	// ErrWrongType indicates a type mismatch, either in the query or on the key.
+	ErrWrongType = errors.New("falkordb: wrong type")
+
+	// ErrReadOnly indicates a write query was sent through ROQuery.
+	ErrReadOnly = errors.New("falkordb: write query in read-only context")
+
+	// ErrInvalidParameter indicates a query parameter could not be encoded.
+	// It is returned before the query is sent to the server.
+	ErrInvalidParameter = errors.New("falkordb: invalid query parameter")
+
+	// ErrMissingParameter indicates a prepared statement was executed
+	// without a value for one of its parameters.
+	ErrMissingParameter = errors.New("falkordb: missing query parameter")
+
+	// ErrUnknownParameter indicates a prepared statement was executed
+	// with a parameter its query does not reference.
+	ErrUnknownParameter = errors.New("falkordb: unknown query parameter")
+
+	// ErrQueryRejected indicates a QueryGuard refused to run a query.
+	// The returned error is a *GuardError describing the offending operations.
+	ErrQueryRejected = errors.New("falkordb: query rejected by guard")
+
+	// ErrInvalidConfig indicates a configuration value has the wrong type
+	// for its setting. It is returned before the value is sent to the server.
+	ErrInvalidConfig = errors.New("falkordb: invalid configuration value")
+
+	// ErrUnsupported indicates the server's FalkorDB version is too old for
+	// a helper. The returned error is an *UnsupportedError naming the
+	// required version. It is only returned once capabilities are detected.
+	ErrUnsupported = errors.New("falkordb: unsupported by server")
+)
+
+// Error is returned for errors reported by the FalkorDB server.
+// It matches one of the sentinel errors with errors.Is when the failure
+// could be classified, and always unwraps to the underlying client error.
+type Error struct {
+	// Kind is the sentinel error this failure was classified as, or nil.
+	Kind error
+
+	// Message is the original error message returned by the server.
+	Message string
+
+	// Graph is the name of the graph the command targeted.
+	Graph string
+
+	// Query is the Cypher query that failed, if any.
+	Query string
+
+	// Tags are the client-side tags of the query, if any.
+	Tags map[string]string
+
+	err error
+}
+
+// Error returns the original server message.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the sentinel kind and the underlying client error.
+func (e *Error) Unwrap() []error {
+	if e.Kind == nil {
+		return []error{e.err}
+	}
+	return []error{e.Kind, e.err}
+}
+
+// errorKinds maps protocol error classifications to sentinel errors.
+var errorKinds = map[proto.ErrorKind]error{
+	proto.ErrorKindSyntax:          ErrSyntax,
+	proto.ErrorKindUnknownFunction: ErrUnknownFunction,
+	proto.ErrorKindTimeout:         ErrQueryTimeout,
+	proto.ErrorKindConstraint:      ErrConstraintViolation,
+	proto.ErrorKindUnknownGraph:    ErrUnknownGraph,
+	proto.ErrorKindWrongType:       ErrWrongType,
+	proto.ErrorKindReadOnly:        ErrReadOnly,
+}
+
+// wrapError converts a server error reply into an *Error.
+// Network, context and client-side errors are returned unchanged.
+func wrapError(err error, graph, query string) error {
+	if !redis.IsServerError(err) {
+		return err
+	}
+	msg := err.Error()
+	return &Error{
+		Kind:    errorKinds[proto.ClassifyError(msg)],
+		Message: msg,
+		Graph:   graph,
+		Query:   query,
+		err:     err,
+	}
+}
@@ -93,10 +93,12 @@ func ExampleGraph_Explain() {
 	graph := db.SelectGraph("example")
 
 	// Get the execution plan without executing
-	plan, _ := graph.Explain(ctx, "MATCH (p:Person)-[:KNOWS]->(f) RETURN p, f")
+	plan, _ := graph.Explain(ctx, "MATCH (p:Person {name: $name})-[:KNOWS]->(f) RETURN p, f",
+		falkordb.WithParam("name", "Alice"))
 
-	for _, step := range plan {
-		fmt.Println(step)
+	fmt.Println(plan)
+	for _, op := range plan.Operations() {
+		fmt.Println(op.Name, op.Args)
 	}
 }
 
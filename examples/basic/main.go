@@ -113,9 +113,7 @@ func demonstrateIndexes(ctx context.Context, graph *falkordb.Graph) {
 		log.Fatalf("Failed to explain: %v", err)
 	}
 	fmt.Println("\nQuery execution plan:")
-	for _, step := range plan {
-		fmt.Printf("  %s\n", step)
-	}
+	fmt.Println(plan)
 
 	// Profile a query
 	profile, err := graph.Profile(ctx, "MATCH (p:Person) RETURN p")
@@ -123,8 +121,8 @@ func demonstrateIndexes(ctx context.Context, graph *falkordb.Graph) {
 		log.Fatalf("Failed to profile: %v", err)
 	}
 	fmt.Println("\nQuery profile:")
-	for _, step := range profile {
-		fmt.Printf("  %s\n", step)
+	for _, op := range profile.Operations() {
+		fmt.Printf("  %s: %d records in %v\n", op.Name, op.RecordsProduced, op.ExecutionTime)
 	}
 
 	// Get slow log
@@ -3,6 +3,7 @@ package falkordb
 import (
 	"context"
 	"strings"
+	"sync/atomic"
 
 	"github.com/flancast90/falkordb-go/internal/redis"
 )
@@ -12,6 +13,14 @@ import (
 type FalkorDB struct {
 	client redis.Client
 	opts   *Options
+	cache  *resultCache
+
+	// flights deduplicates concurrent read queries across the graphs
+	// selected from this client.
+	flights flightGroup
+
+	// caps holds the detected server capabilities, or nil.
+	caps atomic.Pointer[Capabilities]
 }
 
 // Connect establishes a connection to FalkorDB.
@@ -49,93 +58,61 @@ func Connect(ctx context.Context, opts *Options) (*FalkorDB, error) {
 		return nil, err
 	}
 
-	return &FalkorDB{
+	db := &FalkorDB{
 		client: client,
 		opts:   opts,
-	}, nil
-}
-
-// SelectGraph returns a Graph instance for the specified graph name.
-// The graph does not need to exist; it will be created on first use.
-func (db *FalkorDB) SelectGraph(name string) *Graph {
-	return &Graph{
-		name:   name,
-		client: db.client,
-		parser: newResultParser(),
-	}
-}
-
-// List returns the names of all graphs in the database.
-func (db *FalkorDB) List(ctx context.Context) ([]string, error) {
-	result, err := db.client.Do(ctx, "GRAPH.LIST").Result()
-	if err != nil {
-		return nil, err
-	}
-
-	arr, ok := result.([]interface{})
-	if !ok {
-		return []string{}, nil
 	}
-
-	graphs := make([]string, len(arr))
-	for i, g := range arr {
-		graphs[i], _ = g.(string)
-	}
-	return graphs, nil
-}
-
-// ConfigGet retrieves a FalkorDB configuration value.
-//
-// Example:
-//
-//	value, _ := db.ConfigGet(ctx, "RESULTSET_SIZE")
-func (db *FalkorDB) ConfigGet(ctx context.Context, key string) (interface{}, error) {
-	result, err := db.client.Do(ctx, "GRAPH.CONFIG", "GET", key).Result()
-	if err != nil {
-		return nil, err
+	if opts.ResultCache != nil {
+		db.cache = newResultCache(*opts.ResultCache)
+		if ch := opts.ResultCache.InvalidationChannel; ch != "" {
+			pubsub := client.Subscribe(ctx, ch)
+			if _, err := pubsub.Receive(ctx); err != nil {
+				pubsub.Close()
+				client.Close()
+				return nil, err
+			}
+			db.cache.listen(pubsub)
+		}
 	}
-
-	if arr, ok := result.([]interface{}); ok && len(arr) >= 2 {
-		return arr[1], nil
+	if opts.DetectCapabilities {
+		_, _ = db.DetectCapabilities(ctx)
 	}
-	return result, nil
+	return db, nil
 }
 
-// ConfigSet sets a FalkorDB configuration value.
-//
-// Example:
+// SelectGraph returns a Graph instance for the specified graph name.
+// The graph does not need to exist; it will be created on first use.
 //
-//	err := db.ConfigSet(ctx, "RESULTSET_SIZE", 10000)
-func (db *FalkorDB) ConfigSet(ctx context.Context, key string, value interface{}) error {
-	return db.client.Do(ctx, "GRAPH.CONFIG", "SET", key, value).Err()
-}
-
-// Info returns server information.
-// If section is provided, returns information for that specific section.
+// The optional defaults apply to every query on the returned graph, on top of
+// Options.QueryDefaults. Options passed to an individual query override them.
 //
 // Example:
 //
-//	info, _ := db.Info(ctx)              // all info
-//	info, _ := db.Info(ctx, "server")    // server section only
-func (db *FalkorDB) Info(ctx context.Context, section ...string) (string, error) {
-	args := []interface{}{"INFO"}
-	if len(section) > 0 {
-		args = append(args, section[0])
-	}
+//	graph := db.SelectGraph("social",
+//		falkordb.WithTimeout(2*time.Second),
+//		falkordb.WithTag("service", "search"),
+//	)
+func (db *FalkorDB) SelectGraph(name string, defaults ...QueryOption) *Graph {
+	options := []QueryOption{&QueryOptions{TimeoutDuration: db.opts.QueryTimeout}}
+	options = append(options, db.opts.QueryDefaults...)
+	options = append(options, defaults...)
 
-	result, err := db.client.Do(ctx, args...).Result()
-	if err != nil {
-		return "", err
-	}
-
-	if s, ok := result.(string); ok {
-		return s, nil
+	return &Graph{
+		name:     name,
+		client:   db.client,
+		parser:   newResultParser(),
+		defaults: resolveQueryOptions(nil, options),
+		cache:    db.cache,
+		flights:  &db.flights,
+		caps:     &db.caps,
 	}
-	return "", nil
 }
 
 // Close closes the connection to FalkorDB.
 func (db *FalkorDB) Close() error {
+	if db.cache != nil {
+		_ = db.cache.close()
+	}
 	return db.client.Close()
 }
 
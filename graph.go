@@ -2,8 +2,13 @@ package falkordb
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/flancast90/falkordb-go/internal/proto"
 	"github.com/flancast90/falkordb-go/internal/redis"
@@ -12,10 +17,14 @@ import (
 // Graph represents a FalkorDB graph and provides methods to interact with it.
 // It is safe for concurrent use by multiple goroutines.
 type Graph struct {
-	name   string
-	client redis.Client
-	parser *resultParser
-	mu     sync.RWMutex
+	name     string
+	client   redis.Client
+	parser   *resultParser
+	defaults *QueryOptions
+	cache    *resultCache
+	flights  *flightGroup
+	caps     *atomic.Pointer[Capabilities]
+	mu       sync.RWMutex
 }
 
 // Name returns the name of the graph.
@@ -32,120 +41,212 @@ func (g *Graph) Name() string {
 //			Params: map[string]interface{}{"name": "Alice"},
 //		},
 //	)
-func (g *Graph) Query(ctx context.Context, query string, options ...*QueryOptions) (*QueryResult, error) {
+//
+//	// or, equivalently, with functional options
+//	result, err := graph.Query(ctx, "CREATE (n:Person {name: $name}) RETURN n",
+//		falkordb.WithParam("name", "Alice"),
+//	)
+//
+// If ctx has a deadline, the time remaining is sent to the server as the
+// query TIMEOUT so the query is aborted there as well.
+func (g *Graph) Query(ctx context.Context, query string, options ...QueryOption) (*QueryResult, error) {
 	return g.execute(ctx, "GRAPH.QUERY", query, options...)
 }
 
 // ROQuery executes a read-only Cypher query on the graph.
 // Use this for queries that don't modify data to enable query caching
 // and replica reads in cluster mode.
-func (g *Graph) ROQuery(ctx context.Context, query string, options ...*QueryOptions) (*QueryResult, error) {
+func (g *Graph) ROQuery(ctx context.Context, query string, options ...QueryOption) (*QueryResult, error) {
 	return g.execute(ctx, "GRAPH.RO_QUERY", query, options...)
 }
 
-func (g *Graph) execute(ctx context.Context, cmd, query string, options ...*QueryOptions) (*QueryResult, error) {
-	var opts *QueryOptions
-	if len(options) > 0 {
-		opts = options[0]
-	}
+func (g *Graph) execute(ctx context.Context, cmd, query string, options ...QueryOption) (*QueryResult, error) {
+	opts := resolveQueryOptions(g.defaults, options)
 
-	var params map[string]interface{}
-	var timeout int
-	if opts != nil {
-		params = opts.Params
-		timeout = opts.Timeout
+	if opts.Guard != nil {
+		if err := opts.Guard.check(ctx, g, query, opts); err != nil {
+			return nil, err
+		}
 	}
 
-	args := proto.BuildQueryArgs(cmd, g.name, query, params, timeout, true)
-	result, err := g.client.Do(ctx, args...).Result()
+	timeout, err := serverTimeout(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Update metadata cache if needed
-	g.updateMetadataFromResult(ctx)
+	args, err := proto.BuildQueryArgs(cmd, g.name, query, opts.Params, timeout, true)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidParameter, err)
+	}
+	readOnly := cmd == "GRAPH.RO_QUERY"
+
+	// Read-only queries are cached and deduplicated by their text with
+	// parameters, which are serialized in a canonical order
+	key := cacheKey{graph: g.name, query: args[2].(string)}
+	var gen uint64
+	if readOnly && g.cache != nil {
+		var cached *QueryResult
+		if cached, gen = g.cache.get(key); cached != nil {
+			return cached, nil
+		}
+	}
+
+	fetch := func(ctx context.Context) (*QueryResult, error) {
+		result, err := g.do(ctx, opts, readOnly, args)
+		if err != nil {
+			return nil, g.queryError(err, query, opts)
+		}
+
+		// Update metadata cache if needed
+		g.updateMetadataFromResult(ctx)
 
-	raw, err := proto.ParseResult(result)
+		raw, err := proto.ParseResult(result)
+		if err != nil {
+			return nil, err
+		}
+
+		g.mu.RLock()
+		defer g.mu.RUnlock()
+		return g.parser.parseResult(raw)
+	}
+
+	var res *QueryResult
+	if readOnly && opts.Dedup {
+		fk := flightKey{cacheKey: key, writes: g.flights.generation(g.name), cacheGen: gen}
+		res, err = g.flights.do(ctx, fk, fetch)
+	} else {
+		res, err = fetch(ctx)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	g.mu.RLock()
-	defer g.mu.RUnlock()
-	return g.parser.parseResult(raw)
+	if readOnly {
+		if g.cache != nil {
+			g.cache.put(key, gen, res)
+		}
+	} else if res.Stats.ContainsUpdates() {
+		g.invalidateCache(ctx, g.name)
+	}
+	return res, nil
+}
+
+// queryError wraps a query failure and attaches the query's tags.
+func (g *Graph) queryError(err error, query string, opts *QueryOptions) error {
+	err = wrapError(err, g.name, query)
+	var fErr *Error
+	if errors.As(err, &fErr) && len(opts.Tags) > 0 {
+		fErr.Tags = opts.Tags
+	}
+	return err
+}
+
+// do sends a command, retrying transient failures according to policy.
+// Read-only commands follow the read preference: with a Hedge they race the
+// preferred node against the other one, and with ReadReplica alone they go
+// to a replica of the graph's shard. Other commands go to the primary.
+func (g *Graph) do(ctx context.Context, opts *QueryOptions, readOnly bool, args []interface{}) (interface{}, error) {
+	send := func() (interface{}, error) {
+		return g.client.Do(ctx, args...).Result()
+	}
+	switch {
+	case readOnly && opts.Hedge != nil:
+		send = func() (interface{}, error) {
+			targets := g.client.ReadTargets(ctx, g.name)
+			if opts.ReadPreference != ReadReplica {
+				// Targets list a replica first; start with the primary
+				slices.Reverse(targets)
+			}
+			return opts.Hedge.do(ctx, targets, args)
+		}
+	case readOnly && opts.ReadPreference == ReadReplica:
+		send = func() (interface{}, error) {
+			return g.client.DoReplica(ctx, g.name, args...).Result()
+		}
+	}
+
+	policy := opts.Retry
+	for attempt := 1; ; attempt++ {
+		result, err := send()
+		if err == nil || policy == nil || attempt >= policy.MaxAttempts || !redis.IsRetryable(err) {
+			return result, err
+		}
+
+		timer := time.NewTimer(policy.delay(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+	}
 }
 
 // Delete removes the graph from the database.
 func (g *Graph) Delete(ctx context.Context) error {
-	return g.client.Do(ctx, "GRAPH.DELETE", g.name).Err()
+	if err := g.client.Do(ctx, "GRAPH.DELETE", g.name).Err(); err != nil {
+		return wrapError(err, g.name, "")
+	}
+	g.invalidateCache(ctx, g.name)
+	return nil
 }
 
 // Copy creates a copy of the graph with a new name.
 func (g *Graph) Copy(ctx context.Context, destGraph string) error {
-	return g.client.Do(ctx, "GRAPH.COPY", g.name, destGraph).Err()
+	if err := g.require(FeatureCopy); err != nil {
+		return err
+	}
+	if err := g.client.Do(ctx, "GRAPH.COPY", g.name, destGraph).Err(); err != nil {
+		return wrapError(err, g.name, "")
+	}
+	g.invalidateCache(ctx, destGraph)
+	return nil
 }
 
 // Explain returns the execution plan for a query without executing it.
-func (g *Graph) Explain(ctx context.Context, query string) ([]string, error) {
-	result, err := g.client.Do(ctx, "GRAPH.EXPLAIN", g.name, query).Result()
-	if err != nil {
-		return nil, err
-	}
-	return proto.ParseExplainResult(result)
+// Parameters in the options are bound so parameterized queries can be planned.
+func (g *Graph) Explain(ctx context.Context, query string, options ...QueryOption) (*ExecutionPlan, error) {
+	return g.plan(ctx, "GRAPH.EXPLAIN", query, resolveQueryOptions(g.defaults, options))
 }
 
-// Profile executes a query and returns execution profiling information.
-func (g *Graph) Profile(ctx context.Context, query string) ([]string, error) {
-	result, err := g.client.Do(ctx, "GRAPH.PROFILE", g.name, query).Result()
+// Profile executes a query and returns its execution plan annotated with
+// the records produced and time spent by each operation.
+//
+// The profile does not report whether the query changed the graph, so a
+// profiled query always drops the graph's cached ROQuery results.
+func (g *Graph) Profile(ctx context.Context, query string, options ...QueryOption) (*ExecutionPlan, error) {
+	plan, err := g.plan(ctx, "GRAPH.PROFILE", query, resolveQueryOptions(g.defaults, options))
 	if err != nil {
 		return nil, err
 	}
-	return proto.ParseExplainResult(result)
+	g.invalidateCache(ctx, g.name)
+	return plan, nil
 }
 
-// SlowLog returns the slow query log for this graph.
-func (g *Graph) SlowLog(ctx context.Context) ([]SlowLogEntry, error) {
-	result, err := g.client.Do(ctx, "GRAPH.SLOWLOG", g.name).Result()
-	if err != nil {
-		return nil, err
+func (g *Graph) plan(ctx context.Context, cmd, query string, opts *QueryOptions) (*ExecutionPlan, error) {
+	// Only PROFILE executes the query, so only it takes a timeout
+	timeout := 0
+	if cmd == "GRAPH.PROFILE" {
+		var err error
+		if timeout, err = serverTimeout(ctx, opts); err != nil {
+			return nil, err
+		}
 	}
 
-	raw, err := proto.ParseSlowLogResult(result)
+	args, err := proto.BuildQueryArgs(cmd, g.name, query, opts.Params, timeout, false)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %w", ErrInvalidParameter, err)
 	}
-
-	entries := make([]SlowLogEntry, len(raw))
-	for i, r := range raw {
-		entries[i] = SlowLogEntry{
-			Timestamp: proto.ToInt64(r["timestamp"]),
-			Command:   proto.ToString(r["command"]),
-			Query:     proto.ToString(r["query"]),
-			Took:      proto.ToFloat64(r["took"]),
-		}
+	result, err := g.do(ctx, opts, false, args)
+	if err != nil {
+		return nil, g.queryError(err, query, opts)
 	}
-	return entries, nil
-}
-
-// SlowLogEntry represents an entry in the slow query log.
-type SlowLogEntry struct {
-	Timestamp int64
-	Command   string
-	Query     string
-	Took      float64 // Duration in milliseconds
-}
 
-// MemoryUsage returns memory usage statistics for the graph.
-func (g *Graph) MemoryUsage(ctx context.Context) ([]interface{}, error) {
-	result, err := g.client.Do(ctx, "GRAPH.MEMORY", g.name).Result()
+	lines, err := proto.ParseExplainResult(result)
 	if err != nil {
 		return nil, err
 	}
-
-	if arr, ok := result.([]interface{}); ok {
-		return arr, nil
-	}
-	return nil, nil
+	return parsePlan(lines)
 }
 
 // === Index Methods ===
@@ -224,22 +325,24 @@ func (g *Graph) DropEdgeVectorIndex(ctx context.Context, label, property string)
 
 // createTypedIndex creates an index using Cypher syntax
 func (g *Graph) createTypedIndex(ctx context.Context, indexType, entityType, label string, options map[string]interface{}, properties ...string) (*QueryResult, error) {
-	// Build pattern: (e:Label) for nodes, ()-[e:Label]->() for edges
-	var pattern string
-	if entityType == "NODE" {
-		pattern = fmt.Sprintf("(e:%s)", label)
-	} else {
-		pattern = fmt.Sprintf("()-[e:%s]->()", label)
+	if indexType == "VECTOR" {
+		if err := g.require(FeatureVectorIndex); err != nil {
+			return nil, err
+		}
+	}
+	pattern, err := indexPattern(entityType, label)
+	if err != nil {
+		return nil, err
 	}
 
-	// Build property list: e.prop1, e.prop2
-	propList := ""
+	// Build property list: e.`prop1`, e.`prop2`
+	props := make([]string, len(properties))
 	for i, p := range properties {
-		if i > 0 {
-			propList += ", "
+		if props[i], err = indexProperty(p); err != nil {
+			return nil, err
 		}
-		propList += "e." + p
 	}
+	propList := strings.Join(props, ", ")
 
 	// Build query: CREATE [FULLTEXT|VECTOR] INDEX FOR pattern ON (props) [OPTIONS {...}]
 	var query string
@@ -249,21 +352,13 @@ func (g *Graph) createTypedIndex(ctx context.Context, indexType, entityType, lab
 		query = fmt.Sprintf("CREATE INDEX FOR %s ON (%s)", pattern, propList)
 	}
 
-	// Add options for vector index
-	if options != nil && len(options) > 0 {
-		optStr := ""
-		for k, v := range options {
-			if optStr != "" {
-				optStr += ", "
-			}
-			switch val := v.(type) {
-			case string:
-				optStr += fmt.Sprintf("%s:'%s'", k, val)
-			default:
-				optStr += fmt.Sprintf("%s:%v", k, val)
-			}
+	// Add options for vector index, encoded like query parameters
+	if len(options) > 0 {
+		optStr, err := proto.ValueToString(options)
+		if err != nil {
+			return nil, fmt.Errorf("%w: index options: %w", ErrInvalidParameter, err)
 		}
-		query += fmt.Sprintf(" OPTIONS {%s}", optStr)
+		query += " OPTIONS " + optStr
 	}
 
 	return g.Query(ctx, query)
@@ -271,25 +366,53 @@ func (g *Graph) createTypedIndex(ctx context.Context, indexType, entityType, lab
 
 // dropTypedIndex drops an index using Cypher syntax
 func (g *Graph) dropTypedIndex(ctx context.Context, indexType, entityType, label, property string) (*QueryResult, error) {
-	// Build pattern: (e:Label) for nodes, ()-[e:Label]->() for edges
-	var pattern string
-	if entityType == "NODE" {
-		pattern = fmt.Sprintf("(e:%s)", label)
-	} else {
-		pattern = fmt.Sprintf("()-[e:%s]->()", label)
+	if indexType == "VECTOR" {
+		if err := g.require(FeatureVectorIndex); err != nil {
+			return nil, err
+		}
+	}
+	pattern, err := indexPattern(entityType, label)
+	if err != nil {
+		return nil, err
+	}
+	prop, err := indexProperty(property)
+	if err != nil {
+		return nil, err
 	}
 
-	// Build query: DROP [FULLTEXT|VECTOR] INDEX FOR pattern ON (e.prop)
+	// Build query: DROP [FULLTEXT|VECTOR] INDEX FOR pattern ON (e.`prop`)
 	var query string
 	if indexType != "" {
-		query = fmt.Sprintf("DROP %s INDEX FOR %s ON (e.%s)", indexType, pattern, property)
+		query = fmt.Sprintf("DROP %s INDEX FOR %s ON (%s)", indexType, pattern, prop)
 	} else {
-		query = fmt.Sprintf("DROP INDEX FOR %s ON (e.%s)", pattern, property)
+		query = fmt.Sprintf("DROP INDEX FOR %s ON (%s)", pattern, prop)
 	}
 
 	return g.Query(ctx, query)
 }
 
+// indexPattern builds the index pattern with a quoted label:
+// (e:`Label`) for nodes, ()-[e:`Label`]->() for edges.
+func indexPattern(entityType, label string) (string, error) {
+	quoted, err := proto.QuoteIdentifier(label)
+	if err != nil {
+		return "", fmt.Errorf("%w: label: %w", ErrInvalidParameter, err)
+	}
+	if entityType == "NODE" {
+		return "(e:" + quoted + ")", nil
+	}
+	return "()-[e:" + quoted + "]->()", nil
+}
+
+// indexProperty returns the quoted property reference e.`prop`.
+func indexProperty(property string) (string, error) {
+	quoted, err := proto.QuoteIdentifier(property)
+	if err != nil {
+		return "", fmt.Errorf("%w: property: %w", ErrInvalidParameter, err)
+	}
+	return "e." + quoted, nil
+}
+
 // === Constraint Methods ===
 
 // ConstraintCreate creates a constraint on the graph.
@@ -302,14 +425,20 @@ func (g *Graph) dropTypedIndex(ctx context.Context, indexType, entityType, label
 //	// Create mandatory constraint
 //	graph.ConstraintCreate(ctx, falkordb.ConstraintMandatory, falkordb.EntityNode, "Person", "name")
 func (g *Graph) ConstraintCreate(ctx context.Context, constraintType ConstraintType, entityType EntityType, label string, properties ...string) error {
+	if err := g.require(FeatureConstraints); err != nil {
+		return err
+	}
 	args := proto.BuildConstraintArgs("CREATE", g.name, string(constraintType), string(entityType), label, properties)
-	return g.client.Do(ctx, args...).Err()
+	return wrapError(g.client.Do(ctx, args...).Err(), g.name, "")
 }
 
 // ConstraintDrop removes a constraint from the graph.
 func (g *Graph) ConstraintDrop(ctx context.Context, constraintType ConstraintType, entityType EntityType, label string, properties ...string) error {
+	if err := g.require(FeatureConstraints); err != nil {
+		return err
+	}
 	args := proto.BuildConstraintArgs("DROP", g.name, string(constraintType), string(entityType), label, properties)
-	return g.client.Do(ctx, args...).Err()
+	return wrapError(g.client.Do(ctx, args...).Err(), g.name, "")
 }
 
 // updateMetadataFromResult fetches and caches graph metadata (labels, types, property keys).
@@ -0,0 +1,46 @@
+package falkordb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIndexPattern(t *testing.T) {
+	tests := []struct {
+		entityType string
+		label      string
+		expected   string
+	}{
+		{"NODE", "Person", "(e:`Person`)"},
+		{"NODE", "Big Person", "(e:`Big Person`)"},
+		{"NODE", "MATCH", "(e:`MATCH`)"},
+		{"NODE", "x`) DETACH DELETE e //", "(e:`x``) DETACH DELETE e //`)"},
+		{"EDGE", "KNOWS", "()-[e:`KNOWS`]->()"},
+	}
+
+	for _, tc := range tests {
+		result, err := indexPattern(tc.entityType, tc.label)
+		if err != nil {
+			t.Errorf("indexPattern(%q, %q) failed: %v", tc.entityType, tc.label, err)
+			continue
+		}
+		if result != tc.expected {
+			t.Errorf("indexPattern(%q, %q) = %s, expected %s", tc.entityType, tc.label, result, tc.expected)
+		}
+	}
+
+	if _, err := indexPattern("NODE", ""); !errors.Is(err, ErrInvalidParameter) {
+		t.Errorf("Expected ErrInvalidParameter for empty label, got %v", err)
+	}
+}
+
+func TestIndexProperty(t *testing.T) {
+	result, err := indexProperty("first name")
+	if err != nil || result != "e.`first name`" {
+		t.Errorf("indexProperty = %s (%v), expected e.`first name`", result, err)
+	}
+
+	if _, err := indexProperty("bad\xff"); !errors.Is(err, ErrInvalidParameter) {
+		t.Errorf("Expected ErrInvalidParameter for invalid UTF-8, got %v", err)
+	}
+}
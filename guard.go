@@ -0,0 +1,134 @@
+package falkordb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// defaultGuardCacheSize is the number of plans a QueryGuard keeps by default.
+const defaultGuardCacheSize = 1000
+
+// QueryGuard rejects queries whose execution plans break its rules before
+// they are run. Enable it for a graph with WithGuard:
+//
+//	guard := falkordb.NewQueryGuard(falkordb.LintAllNodeScan, falkordb.LintMissingLimit)
+//	graph := db.SelectGraph("search", falkordb.WithGuard(guard))
+//
+// Each query text is explained once per graph and the verdict is cached,
+// so only the first execution of a query pays for the extra round trip.
+// A QueryGuard is safe for concurrent use and may be shared between graphs.
+type QueryGuard struct {
+	rules     []LintRule
+	cacheSize int
+
+	mu       sync.Mutex
+	verdicts map[guardKey][]LintFinding
+}
+
+type guardKey struct {
+	graph string
+	query string
+}
+
+// NewQueryGuard returns a guard that rejects plans matching any of rules.
+// With no rules it rejects full node scans, Cartesian products and queries
+// without a LIMIT.
+func NewQueryGuard(rules ...LintRule) *QueryGuard {
+	if len(rules) == 0 {
+		rules = []LintRule{LintAllNodeScan, LintCartesianProduct, LintMissingLimit}
+	}
+	return &QueryGuard{
+		rules:     rules,
+		cacheSize: defaultGuardCacheSize,
+		verdicts:  make(map[guardKey][]LintFinding),
+	}
+}
+
+// SetCacheSize sets the maximum number of cached plan verdicts.
+// Values below 1 disable caching.
+func (q *QueryGuard) SetCacheSize(n int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.cacheSize = n
+	for key := range q.verdicts {
+		if len(q.verdicts) <= n {
+			break
+		}
+		delete(q.verdicts, key)
+	}
+}
+
+// Reset clears the cached verdicts, for example after creating an index
+// that changes how queries are planned.
+func (q *QueryGuard) Reset() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.verdicts = make(map[guardKey][]LintFinding)
+}
+
+// check explains query on g, unless its verdict is cached, and returns a
+// *GuardError if the plan breaks a rule.
+func (q *QueryGuard) check(ctx context.Context, g *Graph, query string, opts *QueryOptions) error {
+	key := guardKey{graph: g.name, query: query}
+
+	q.mu.Lock()
+	findings, ok := q.verdicts[key]
+	q.mu.Unlock()
+
+	if !ok {
+		plan, err := g.plan(ctx, "GRAPH.EXPLAIN", query, opts)
+		if err != nil {
+			return err
+		}
+		findings = LintPlan(query, plan, q.rules...)
+		q.store(key, findings)
+	}
+
+	if len(findings) > 0 {
+		return &GuardError{Graph: g.name, Query: query, Findings: findings}
+	}
+	return nil
+}
+
+// store caches a verdict, evicting an arbitrary entry when the cache is full.
+func (q *QueryGuard) store(key guardKey, findings []LintFinding) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.cacheSize < 1 {
+		return
+	}
+	if len(q.verdicts) >= q.cacheSize {
+		for k := range q.verdicts {
+			delete(q.verdicts, k)
+			break
+		}
+	}
+	q.verdicts[key] = findings
+}
+
+// GuardError is returned when a QueryGuard refuses to run a query.
+// It matches ErrQueryRejected with errors.Is.
+type GuardError struct {
+	Graph string
+	Query string
+
+	// Findings are the rule violations in the query's plan. Each one
+	// names the offending Operation.
+	Findings []LintFinding
+}
+
+func (e *GuardError) Error() string {
+	msgs := make([]string, len(e.Findings))
+	for i, f := range e.Findings {
+		msgs[i] = fmt.Sprintf("%s: %s", f.Rule, f.Message)
+	}
+	return fmt.Sprintf("%s: %s", ErrQueryRejected, strings.Join(msgs, "; "))
+}
+
+func (e *GuardError) Unwrap() error {
+	return ErrQueryRejected
+}
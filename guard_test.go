@@ -0,0 +1,105 @@
+package falkordb
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// planClient returns a fakeClient that answers GRAPH.EXPLAIN with plan
+// and every other command with an empty result.
+func planClient(plan ...string) *fakeClient {
+	return &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		if args[0] == "GRAPH.EXPLAIN" {
+			lines := make([]interface{}, len(plan))
+			for i, line := range plan {
+				lines[i] = line
+			}
+			return lines, nil
+		}
+		return []interface{}{[]interface{}{}}, nil
+	}}
+}
+
+func TestQueryGuardRejects(t *testing.T) {
+	client := planClient("Results", "    Project", "        All Node Scan | (n)")
+	graph := newTestGraph(client, WithGuard(NewQueryGuard()))
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := graph.ROQuery(ctx, "MATCH (n) RETURN n")
+		if !errors.Is(err, ErrQueryRejected) {
+			t.Fatalf("Expected ErrQueryRejected, got %v", err)
+		}
+
+		var gErr *GuardError
+		if !errors.As(err, &gErr) {
+			t.Fatalf("Expected *GuardError, got %T", err)
+		}
+		if len(gErr.Findings) != 2 || gErr.Findings[0].Rule != LintAllNodeScan || gErr.Findings[1].Rule != LintMissingLimit {
+			t.Errorf("Unexpected findings: %v", gErr.Findings)
+		}
+		if gErr.Findings[0].Operation.Name != "All Node Scan" {
+			t.Errorf("Unexpected operation: %+v", gErr.Findings[0].Operation)
+		}
+	}
+
+	if n := client.count("GRAPH.EXPLAIN"); n != 1 {
+		t.Errorf("Expected the plan to be explained once, got %d", n)
+	}
+	if n := client.count("GRAPH.RO_QUERY"); n != 0 {
+		t.Errorf("Rejected query was sent to the server")
+	}
+}
+
+func TestQueryGuardAllows(t *testing.T) {
+	client := planClient("Results", "    Limit", "        Node By Label Scan | (n:Person)")
+	guard := NewQueryGuard()
+	graph := newTestGraph(client)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := graph.Query(ctx, "MATCH (n:Person) RETURN n LIMIT 10", WithGuard(guard)); err != nil {
+			t.Fatalf("Query failed: %v", err)
+		}
+	}
+	if client.count("GRAPH.EXPLAIN") != 1 || client.count("GRAPH.QUERY") != 3 {
+		t.Errorf("Unexpected commands: %v", client.commands)
+	}
+
+	// Without the guard the plan is not checked
+	if _, err := graph.Query(ctx, "MATCH (n) RETURN n"); err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if client.count("GRAPH.EXPLAIN") != 1 {
+		t.Errorf("Unguarded query was explained")
+	}
+
+	guard.Reset()
+	if _, err := graph.Query(ctx, "MATCH (n:Person) RETURN n LIMIT 10", WithGuard(guard)); err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if client.count("GRAPH.EXPLAIN") != 2 {
+		t.Errorf("Expected Reset to clear cached verdicts")
+	}
+}
+
+func TestQueryGuardCacheSize(t *testing.T) {
+	client := planClient("Results", "    Limit", "        Node By Label Scan | (n:Person)")
+	guard := NewQueryGuard(LintCartesianProduct)
+	guard.SetCacheSize(1)
+	graph := newTestGraph(client, WithGuard(guard))
+	ctx := context.Background()
+
+	for _, q := range []string{"RETURN 1", "RETURN 2", "RETURN 1"} {
+		if _, err := graph.ROQuery(ctx, q); err != nil {
+			t.Fatalf("ROQuery failed: %v", err)
+		}
+	}
+	if n := client.count("GRAPH.EXPLAIN"); n != 3 {
+		t.Errorf("Expected 3 explains with a cache of one, got %d", n)
+	}
+	if len(guard.verdicts) != 1 {
+		t.Errorf("Expected 1 cached verdict, got %d", len(guard.verdicts))
+	}
+}
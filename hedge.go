@@ -0,0 +1,102 @@
+package falkordb
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/flancast90/falkordb-go/internal/redis"
+)
+
+// Hedge tuning.
+const (
+	// hedgeWindow is the number of recent latencies the delay is computed from.
+	hedgeWindow = 1000
+
+	// hedgeMinSamples is the number of latencies needed before the
+	// percentile replaces the initial delay.
+	hedgeMinSamples = 20
+
+	// hedgeRecompute is how many new latencies trigger recomputing the delay.
+	hedgeRecompute = 50
+)
+
+// Hedge sends a second copy of a read-only query to another node when the
+// first has not answered within a percentile of recent query latencies.
+// The first reply wins and the other request is cancelled. Enable it with
+// WithHedge:
+//
+//	hedge := falkordb.NewHedge(0.95, 50*time.Millisecond)
+//	graph := db.SelectGraph("social", falkordb.WithHedge(hedge))
+//
+// In cluster mode the copy goes to the primary if the query was sent to a
+// replica (see ReadPreference), or to a replica otherwise. With a single
+// server the copy is sent to the same server on another connection. Sentinel
+// deployments behave like a single server: the copy always goes to the
+// current master, never to a replica.
+//
+// A Hedge is safe for concurrent use. Share one between graphs to pool
+// their latencies, or use one per graph to track them separately.
+type Hedge struct {
+	percentile float64
+	initial    time.Duration
+
+	mu      sync.Mutex
+	samples []time.Duration // ring buffer of recent latencies
+	next    int
+	pending int // samples since delay was computed
+	delay   time.Duration
+}
+
+// NewHedge returns a Hedge that waits for the given percentile of recent
+// latencies, such as 0.95, before sending the second request. Until enough
+// queries have completed it waits for initialDelay.
+func NewHedge(percentile float64, initialDelay time.Duration) *Hedge {
+	return &Hedge{
+		percentile: math.Min(math.Max(percentile, 0), 1),
+		initial:    initialDelay,
+		samples:    make([]time.Duration, 0, hedgeWindow),
+		delay:      initialDelay,
+	}
+}
+
+// Delay returns how long a query currently waits before it is hedged.
+func (h *Hedge) Delay() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.delay
+}
+
+// observe records the latency of a completed query.
+func (h *Hedge) observe(latency time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.samples) < hedgeWindow {
+		h.samples = append(h.samples, latency)
+	} else {
+		h.samples[h.next] = latency
+		h.next = (h.next + 1) % hedgeWindow
+	}
+
+	h.pending++
+	if len(h.samples) >= hedgeMinSamples && (h.pending >= hedgeRecompute || len(h.samples) == hedgeMinSamples) {
+		h.pending = 0
+		sorted := append([]time.Duration(nil), h.samples...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		i := int(math.Ceil(h.percentile*float64(len(sorted)))) - 1
+		h.delay = sorted[max(i, 0)]
+	}
+}
+
+// do sends args to targets, hedging after the current delay.
+func (h *Hedge) do(ctx context.Context, targets []redis.Target, args []interface{}) (interface{}, error) {
+	start := time.Now()
+	result, err := redis.DoHedged(ctx, targets, h.Delay(), args...)
+	if err == nil {
+		h.observe(time.Since(start))
+	}
+	return result, err
+}
@@ -0,0 +1,68 @@
+package falkordb
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHedgeDelay(t *testing.T) {
+	hedge := NewHedge(0.95, 50*time.Millisecond)
+
+	for i := 1; i < hedgeMinSamples; i++ {
+		hedge.observe(time.Millisecond)
+	}
+	if d := hedge.Delay(); d != 50*time.Millisecond {
+		t.Errorf("Expected initial delay before enough samples, got %v", d)
+	}
+
+	hedge = NewHedge(0.95, 50*time.Millisecond)
+	// The delay is recomputed at 20, 70 and 120 samples
+	for i := 1; i <= 120; i++ {
+		hedge.observe(time.Duration(i) * time.Millisecond)
+	}
+	if d := hedge.Delay(); d != 114*time.Millisecond {
+		t.Errorf("Expected p95 of 114ms, got %v", d)
+	}
+
+	// Old samples leave the window
+	for i := 0; i < hedgeWindow; i++ {
+		hedge.observe(time.Millisecond)
+	}
+	if d := hedge.Delay(); d != time.Millisecond {
+		t.Errorf("Expected delay to follow recent latencies, got %v", d)
+	}
+}
+
+func TestROQueryHedge(t *testing.T) {
+	var calls int32
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		if args[2] == "RETURN 1" && atomic.AddInt32(&calls, 1) == 1 {
+			time.Sleep(time.Second)
+		}
+		return []interface{}{[]interface{}{}}, nil
+	}}
+	hedge := NewHedge(0.99, 10*time.Millisecond)
+	graph := newTestGraph(client, WithHedge(hedge))
+
+	start := time.Now()
+	if _, err := graph.ROQuery(context.Background(), "RETURN 1"); err != nil {
+		t.Fatalf("ROQuery failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Hedged query took %v", elapsed)
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("Expected 2 requests, got %d", n)
+	}
+
+	// Writes are never hedged
+	atomic.StoreInt32(&calls, 0)
+	if _, err := graph.Query(context.Background(), "RETURN 1"); err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("Expected 1 request, got %d", n)
+	}
+}
@@ -0,0 +1,312 @@
+package falkordb
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+	"github.com/flancast90/falkordb-go/internal/redis"
+)
+
+// falkorModule is the name FalkorDB registers its module under.
+const falkorModule = "graph"
+
+// Version is the version of a server or module.
+type Version struct {
+	Major, Minor, Patch int
+}
+
+// String returns the version as "major.minor.patch".
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Compare returns -1 if v is older than other, +1 if it is newer and 0 if
+// they are equal.
+func (v Version) Compare(other Version) int {
+	for _, d := range [...]int{v.Major - other.Major, v.Minor - other.Minor, v.Patch - other.Patch} {
+		if d < 0 {
+			return -1
+		}
+		if d > 0 {
+			return 1
+		}
+	}
+	return 0
+}
+
+// parseVersion parses a dotted version such as "7.2.4". Missing or
+// malformed components are zero.
+func parseVersion(s string) Version {
+	var parts [3]int
+	for i, p := range strings.SplitN(s, ".", 3) {
+		parts[i], _ = strconv.Atoi(p)
+	}
+	return Version{Major: parts[0], Minor: parts[1], Patch: parts[2]}
+}
+
+// moduleVersion decodes a module version number such as 41202 (4.12.2).
+func moduleVersion(ver int) Version {
+	return Version{Major: ver / 10000, Minor: ver / 100 % 100, Patch: ver % 100}
+}
+
+// ServerInfo is the parsed INFO reply of one server.
+type ServerInfo struct {
+	// Addr is the address of the server. It is set by NodesInfo.
+	Addr string
+
+	// Version is the Redis server version.
+	Version Version
+
+	// Mode is the server mode: standalone, cluster or sentinel.
+	Mode string
+
+	Modules     []ModuleInfo
+	Memory      MemoryInfo
+	Replication ReplicationInfo
+
+	// Keyspace holds key counts per database, keyed by name such as "db0".
+	Keyspace map[string]KeyspaceInfo
+
+	// Sections holds every field of the reply, keyed by lowercase section
+	// name and then by field name. Repeated fields, such as the module
+	// lines, keep their last value; use Modules for those.
+	Sections map[string]map[string]string
+}
+
+// ModuleInfo describes a loaded module.
+type ModuleInfo struct {
+	Name    string
+	Version Version
+
+	// Other holds the remaining module attributes, keyed by name.
+	Other map[string]string
+}
+
+// MemoryInfo is the memory section of INFO.
+type MemoryInfo struct {
+	UsedBytes          int64
+	UsedRSSBytes       int64
+	PeakBytes          int64
+	MaxMemoryBytes     int64
+	MaxMemoryPolicy    string
+	FragmentationRatio float64
+}
+
+// ReplicationInfo is the replication section of INFO.
+type ReplicationInfo struct {
+	// Role is "master" on primaries and "slave" on replicas.
+	Role string
+
+	// MasterOffset is the replication offset of the primary's stream.
+	MasterOffset int64
+
+	// Replicas lists the replicas connected to a primary.
+	Replicas []ReplicaInfo
+
+	// MasterAddr, MasterLinkUp and ReplicaOffset are set on replicas.
+	MasterAddr    string
+	MasterLinkUp  bool
+	ReplicaOffset int64
+}
+
+// ReplicaInfo describes a replica connected to a primary.
+type ReplicaInfo struct {
+	Addr   string
+	State  string
+	Offset int64
+	Lag    time.Duration
+}
+
+// KeyspaceInfo is the key count of one database.
+type KeyspaceInfo struct {
+	Keys    int64
+	Expires int64
+	AvgTTL  time.Duration
+}
+
+// Module returns the loaded module with the given name.
+func (i *ServerInfo) Module(name string) (ModuleInfo, bool) {
+	for _, m := range i.Modules {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return ModuleInfo{}, false
+}
+
+// FalkorDBVersion returns the version of the FalkorDB module, and false if
+// the module is not loaded or the reply did not include the modules section.
+func (i *ServerInfo) FalkorDBVersion() (Version, bool) {
+	m, ok := i.Module(falkorModule)
+	return m.Version, ok
+}
+
+// Info returns server information.
+// If section is provided, returns information for that specific section.
+//
+// Example:
+//
+//	info, _ := db.Info(ctx)              // all info
+//	info, _ := db.Info(ctx, "server")    // server section only
+func (db *FalkorDB) Info(ctx context.Context, section ...string) (string, error) {
+	args := []interface{}{"INFO"}
+	if len(section) > 0 {
+		args = append(args, section[0])
+	}
+
+	result, err := db.client.Do(ctx, args...).Result()
+	if err != nil {
+		return "", err
+	}
+
+	if s, ok := result.(string); ok {
+		return s, nil
+	}
+	return "", nil
+}
+
+// ServerInfo returns the parsed INFO reply of the server. Sections limit
+// the reply to the named sections; all default sections are returned
+// otherwise. In cluster mode the command is sent to a single node; use
+// NodesInfo to query all of them.
+//
+// Example:
+//
+//	info, _ := db.ServerInfo(ctx, "server", "modules")
+//	v, _ := info.FalkorDBVersion()
+func (db *FalkorDB) ServerInfo(ctx context.Context, sections ...string) (*ServerInfo, error) {
+	return serverInfo(ctx, db.client.Do, sections)
+}
+
+// NodesInfo returns the parsed INFO reply of every node, primaries and
+// replicas, sorted by address. A standalone server is a single node.
+func (db *FalkorDB) NodesInfo(ctx context.Context, sections ...string) ([]*ServerInfo, error) {
+	nodes, err := db.client.Nodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*ServerInfo, len(nodes))
+	errs := make([]error, len(nodes))
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		wg.Add(1)
+		go func(i int, node redis.Node) {
+			defer wg.Done()
+			info, err := serverInfo(ctx, node.Do, sections)
+			if err != nil {
+				errs[i] = fmt.Errorf("falkordb: INFO on %s: %w", node.Addr, err)
+				return
+			}
+			info.Addr = node.Addr
+			infos[i] = info
+		}(i, node)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return infos, nil
+}
+
+func serverInfo(ctx context.Context, do redis.Target, sections []string) (*ServerInfo, error) {
+	args := []interface{}{"INFO"}
+	for _, s := range sections {
+		args = append(args, s)
+	}
+
+	result, err := do(ctx, args...).Result()
+	if err != nil {
+		return nil, err
+	}
+	text, ok := result.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected INFO result format: %T", result)
+	}
+	return parseServerInfo(text), nil
+}
+
+// parseServerInfo converts the text of an INFO reply into a ServerInfo.
+func parseServerInfo(text string) *ServerInfo {
+	info := &ServerInfo{Sections: make(map[string]map[string]string)}
+
+	for _, f := range proto.ParseInfo(text) {
+		section := info.Sections[f.Section]
+		if section == nil {
+			section = make(map[string]string)
+			info.Sections[f.Section] = section
+		}
+		section[f.Key] = f.Value
+
+		switch {
+		case f.Section == "modules" && f.Key == "module":
+			info.Modules = append(info.Modules, parseModuleInfo(f.Value))
+		case f.Section == "keyspace":
+			if info.Keyspace == nil {
+				info.Keyspace = make(map[string]KeyspaceInfo)
+			}
+			items := proto.ParseInfoList(f.Value)
+			info.Keyspace[f.Key] = KeyspaceInfo{
+				Keys:    proto.ToInt64(items["keys"]),
+				Expires: proto.ToInt64(items["expires"]),
+				AvgTTL:  time.Duration(proto.ToInt64(items["avg_ttl"])) * time.Millisecond,
+			}
+		case f.Section == "replication" && strings.HasPrefix(f.Key, "slave") && strings.Contains(f.Value, "="):
+			items := proto.ParseInfoList(f.Value)
+			info.Replication.Replicas = append(info.Replication.Replicas, ReplicaInfo{
+				Addr:   items["ip"] + ":" + items["port"],
+				State:  items["state"],
+				Offset: proto.ToInt64(items["offset"]),
+				Lag:    time.Duration(proto.ToInt64(items["lag"])) * time.Second,
+			})
+		}
+	}
+
+	server := info.Sections["server"]
+	info.Version = parseVersion(server["redis_version"])
+	info.Mode = server["redis_mode"]
+
+	memory := info.Sections["memory"]
+	info.Memory = MemoryInfo{
+		UsedBytes:          proto.ToInt64(memory["used_memory"]),
+		UsedRSSBytes:       proto.ToInt64(memory["used_memory_rss"]),
+		PeakBytes:          proto.ToInt64(memory["used_memory_peak"]),
+		MaxMemoryBytes:     proto.ToInt64(memory["maxmemory"]),
+		MaxMemoryPolicy:    memory["maxmemory_policy"],
+		FragmentationRatio: proto.ToFloat64(memory["mem_fragmentation_ratio"]),
+	}
+
+	repl := info.Sections["replication"]
+	info.Replication.Role = repl["role"]
+	info.Replication.MasterOffset = proto.ToInt64(repl["master_repl_offset"])
+	if host := repl["master_host"]; host != "" {
+		info.Replication.MasterAddr = host + ":" + repl["master_port"]
+	}
+	info.Replication.MasterLinkUp = repl["master_link_status"] == "up"
+	info.Replication.ReplicaOffset = proto.ToInt64(repl["slave_repl_offset"])
+
+	return info
+}
+
+// parseModuleInfo parses a module line such as "name=graph,ver=41202,api=1".
+func parseModuleInfo(value string) ModuleInfo {
+	items := proto.ParseInfoList(value)
+	m := ModuleInfo{
+		Name:    items["name"],
+		Version: moduleVersion(proto.ToInt(items["ver"])),
+	}
+	delete(items, "name")
+	delete(items, "ver")
+	if len(items) > 0 {
+		m.Other = items
+	}
+	return m
+}
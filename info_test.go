@@ -0,0 +1,131 @@
+package falkordb
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flancast90/falkordb-go/internal/redis"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const primaryInfo = "# Server\r\nredis_version:7.2.4\r\nredis_mode:cluster\r\n\r\n" +
+	"# Memory\r\nused_memory:1048576\r\nused_memory_rss:2097152\r\nused_memory_peak:3145728\r\n" +
+	"maxmemory:0\r\nmaxmemory_policy:noeviction\r\nmem_fragmentation_ratio:2.00\r\n\r\n" +
+	"# Replication\r\nrole:master\r\nconnected_slaves:1\r\n" +
+	"slave0:ip=10.0.0.2,port=6379,state=online,offset=1200,lag=1\r\nmaster_repl_offset:1234\r\n\r\n" +
+	"# Modules\r\nmodule:name=graph,ver=41202,api=1,filters=0,usedby=[],using=[],options=[]\r\n" +
+	"module:name=ReJSON,ver=20609,api=1,filters=0,usedby=[],using=[],options=[]\r\n\r\n" +
+	"# Keyspace\r\ndb0:keys=3,expires=1,avg_ttl=5000\r\n"
+
+const replicaInfo = "# Server\r\nredis_version:7.2.4\r\n\r\n" +
+	"# Replication\r\nrole:slave\r\nmaster_host:10.0.0.1\r\nmaster_port:6379\r\n" +
+	"master_link_status:up\r\nslave_repl_offset:1200\r\nslave_read_only:1\r\n"
+
+func TestServerInfo(t *testing.T) {
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		return primaryInfo, nil
+	}}
+	db := &FalkorDB{client: client, opts: &Options{}}
+
+	info, err := db.ServerInfo(context.Background(), "server", "modules")
+	if err != nil {
+		t.Fatalf("ServerInfo failed: %v", err)
+	}
+
+	expectedCmd := []interface{}{"INFO", "server", "modules"}
+	if !reflect.DeepEqual(client.commands[0], expectedCmd) {
+		t.Errorf("Unexpected command: %v", client.commands[0])
+	}
+
+	if info.Version != (Version{7, 2, 4}) || info.Mode != "cluster" {
+		t.Errorf("Unexpected server fields: %v %q", info.Version, info.Mode)
+	}
+
+	v, ok := info.FalkorDBVersion()
+	if !ok || v.String() != "4.12.2" {
+		t.Errorf("FalkorDBVersion = %v, %v, expected 4.12.2", v, ok)
+	}
+	if len(info.Modules) != 2 || info.Modules[1].Name != "ReJSON" || info.Modules[0].Other["api"] != "1" {
+		t.Errorf("Unexpected modules: %+v", info.Modules)
+	}
+
+	expectedMemory := MemoryInfo{
+		UsedBytes:          1048576,
+		UsedRSSBytes:       2097152,
+		PeakBytes:          3145728,
+		MaxMemoryPolicy:    "noeviction",
+		FragmentationRatio: 2,
+	}
+	if info.Memory != expectedMemory {
+		t.Errorf("Memory = %+v, expected %+v", info.Memory, expectedMemory)
+	}
+
+	expectedRepl := ReplicationInfo{
+		Role:         "master",
+		MasterOffset: 1234,
+		Replicas:     []ReplicaInfo{{Addr: "10.0.0.2:6379", State: "online", Offset: 1200, Lag: time.Second}},
+	}
+	if !reflect.DeepEqual(info.Replication, expectedRepl) {
+		t.Errorf("Replication = %+v, expected %+v", info.Replication, expectedRepl)
+	}
+
+	expectedKeyspace := map[string]KeyspaceInfo{"db0": {Keys: 3, Expires: 1, AvgTTL: 5 * time.Second}}
+	if !reflect.DeepEqual(info.Keyspace, expectedKeyspace) {
+		t.Errorf("Keyspace = %+v, expected %+v", info.Keyspace, expectedKeyspace)
+	}
+
+	if info.Sections["replication"]["connected_slaves"] != "1" {
+		t.Errorf("Expected raw fields in Sections, got %v", info.Sections["replication"])
+	}
+}
+
+func TestNodesInfo(t *testing.T) {
+	node := func(addr, reply string) redis.Node {
+		return redis.Node{Addr: addr, Primary: strings.HasSuffix(addr, "1:6379"), Do: func(ctx context.Context, args ...interface{}) *goredis.Cmd {
+			cmd := goredis.NewCmd(ctx, args...)
+			cmd.SetVal(reply)
+			return cmd
+		}}
+	}
+	client := &fakeClient{nodes: []redis.Node{
+		node("10.0.0.1:6379", primaryInfo),
+		node("10.0.0.2:6379", replicaInfo),
+	}}
+	db := &FalkorDB{client: client, opts: &Options{}}
+
+	infos, err := db.NodesInfo(context.Background())
+	if err != nil {
+		t.Fatalf("NodesInfo failed: %v", err)
+	}
+	if len(infos) != 2 || infos[0].Addr != "10.0.0.1:6379" || infos[1].Addr != "10.0.0.2:6379" {
+		t.Fatalf("Unexpected nodes: %+v", infos)
+	}
+
+	replica := infos[1].Replication
+	if replica.Role != "slave" || replica.MasterAddr != "10.0.0.1:6379" || !replica.MasterLinkUp || replica.ReplicaOffset != 1200 {
+		t.Errorf("Unexpected replica replication info: %+v", replica)
+	}
+	if _, ok := infos[1].FalkorDBVersion(); ok {
+		t.Error("Expected no FalkorDB version without a modules section")
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"4.2.0", "4.2.0", 0},
+		{"4.2.1", "4.2.0", 1},
+		{"4.10.0", "4.9.9", 1},
+		{"3.9", "4.0.0", -1},
+	}
+	for _, tt := range tests {
+		if got := parseVersion(tt.a).Compare(parseVersion(tt.b)); got != tt.expected {
+			t.Errorf("%s.Compare(%s) = %d, expected %d", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
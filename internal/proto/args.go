@@ -3,17 +3,23 @@ package proto
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // BuildQueryArgs constructs the arguments for a GRAPH.QUERY or GRAPH.RO_QUERY command.
-func BuildQueryArgs(cmd, graph, query string, params map[string]interface{}, timeout int, compact bool) []interface{} {
+// It returns an error if a parameter cannot be encoded.
+func BuildQueryArgs(cmd, graph, query string, params map[string]interface{}, timeout int, compact bool) ([]interface{}, error) {
 	args := []interface{}{cmd, graph}
 
 	// Build query string with params if provided
 	if params != nil && len(params) > 0 {
-		paramStr := paramsToString(params)
+		paramStr, err := paramsToString(params)
+		if err != nil {
+			return nil, err
+		}
 		query = fmt.Sprintf("CYPHER %s %s", paramStr, query)
 	}
 
@@ -29,7 +35,16 @@ func BuildQueryArgs(cmd, graph, query string, params map[string]interface{}, tim
 		args = append(args, "--compact")
 	}
 
-	return args
+	return args, nil
+}
+
+// DurationToMillis converts d to whole milliseconds for a TIMEOUT argument,
+// rounding up so that a positive duration never becomes 0 (no timeout).
+func DurationToMillis(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int((d + time.Millisecond - 1) / time.Millisecond)
 }
 
 // BuildConstraintArgs constructs arguments for constraint commands.
@@ -66,10 +81,15 @@ func BuildIndexArgs(cmd, graph, indexType, entityType, label string, options map
 
 	args = append(args, label)
 
-	// Add options if provided (for vector indices)
+	// Add options if provided (for vector indices), in a stable order
 	if options != nil {
-		for key, value := range options {
-			args = append(args, key, value)
+		keys := make([]string, 0, len(options))
+		for key := range options {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			args = append(args, key, options[key])
 		}
 	}
 
@@ -85,45 +105,25 @@ func BuildIndexArgs(cmd, graph, indexType, entityType, label string, options map
 }
 
 // paramsToString converts query parameters to Cypher parameter string format.
-func paramsToString(params map[string]interface{}) string {
-	var parts []string
-	for key, value := range params {
-		parts = append(parts, fmt.Sprintf("%s=%s", key, ValueToString(value)))
-	}
-	return strings.Join(parts, " ")
-}
-
-// ValueToString converts a parameter value to its Cypher string representation.
-func ValueToString(param interface{}) string {
-	if param == nil {
-		return "null"
+// Parameters are sorted by name so the same parameters always produce the
+// same query text, which keeps the server's query cache effective.
+func paramsToString(params map[string]interface{}) (string, error) {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		if !IsIdentifier(key) {
+			return "", fmt.Errorf("invalid parameter name %q", key)
+		}
+		keys = append(keys, key)
 	}
+	sort.Strings(keys)
 
-	switch v := param.(type) {
-	case string:
-		// Escape quotes and backslashes
-		escaped := strings.ReplaceAll(v, "\\", "\\\\")
-		escaped = strings.ReplaceAll(escaped, "\"", "\\\"")
-		return fmt.Sprintf("\"%s\"", escaped)
-	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
-		return fmt.Sprint(v)
-	case float32, float64:
-		return fmt.Sprint(v)
-	case bool:
-		return fmt.Sprint(v)
-	case []interface{}:
-		var items []string
-		for _, item := range v {
-			items = append(items, ValueToString(item))
-		}
-		return fmt.Sprintf("[%s]", strings.Join(items, ","))
-	case map[string]interface{}:
-		var items []string
-		for key, value := range v {
-			items = append(items, fmt.Sprintf("%s:%s", key, ValueToString(value)))
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		encoded, err := ValueToString(params[key])
+		if err != nil {
+			return "", fmt.Errorf("parameter %q: %w", key, err)
 		}
-		return fmt.Sprintf("{%s}", strings.Join(items, ","))
-	default:
-		return fmt.Sprint(v)
+		parts[i] = key + "=" + encoded
 	}
+	return strings.Join(parts, " "), nil
 }
@@ -1,6 +1,9 @@
 package proto
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestValueToString(t *testing.T) {
 	tests := []struct {
@@ -18,7 +21,11 @@ func TestValueToString(t *testing.T) {
 	}
 
 	for _, tc := range tests {
-		result := ValueToString(tc.input)
+		result, err := ValueToString(tc.input)
+		if err != nil {
+			t.Errorf("ValueToString(%v) failed: %v", tc.input, err)
+			continue
+		}
 		if result != tc.expected {
 			t.Errorf("ValueToString(%v) = %s, expected %s", tc.input, result, tc.expected)
 		}
@@ -36,16 +43,30 @@ func TestValueToStringEscaping(t *testing.T) {
 	}
 
 	for _, tc := range tests {
-		result := ValueToString(tc.input)
+		result, err := ValueToString(tc.input)
+		if err != nil {
+			t.Errorf("ValueToString(%q) failed: %v", tc.input, err)
+			continue
+		}
 		if result != tc.expected {
 			t.Errorf("ValueToString(%q) = %s, expected %s", tc.input, result, tc.expected)
 		}
 	}
 }
 
+func TestBuildQueryArgsInvalidParam(t *testing.T) {
+	_, err := BuildQueryArgs("GRAPH.QUERY", "myGraph", "RETURN $f", map[string]interface{}{"f": func() {}}, 0, true)
+	if err == nil {
+		t.Error("Expected error for unsupported parameter type")
+	}
+}
+
 func TestBuildQueryArgs(t *testing.T) {
 	// Basic query without params
-	args := BuildQueryArgs("GRAPH.QUERY", "myGraph", "MATCH (n) RETURN n", nil, 0, true)
+	args, err := BuildQueryArgs("GRAPH.QUERY", "myGraph", "MATCH (n) RETURN n", nil, 0, true)
+	if err != nil {
+		t.Fatalf("BuildQueryArgs failed: %v", err)
+	}
 
 	if len(args) < 3 {
 		t.Errorf("Expected at least 3 args, got %d", len(args))
@@ -58,8 +79,11 @@ func TestBuildQueryArgs(t *testing.T) {
 	}
 
 	// Query with params
-	args = BuildQueryArgs("GRAPH.QUERY", "myGraph", "MATCH (n) RETURN n",
+	args, err = BuildQueryArgs("GRAPH.QUERY", "myGraph", "MATCH (n) RETURN n",
 		map[string]interface{}{"name": "test"}, 0, true)
+	if err != nil {
+		t.Fatalf("BuildQueryArgs with params failed: %v", err)
+	}
 
 	queryArg := args[2].(string)
 	if queryArg[:6] != "CYPHER" {
@@ -67,7 +91,10 @@ func TestBuildQueryArgs(t *testing.T) {
 	}
 
 	// Query with timeout
-	args = BuildQueryArgs("GRAPH.QUERY", "myGraph", "MATCH (n) RETURN n", nil, 5000, true)
+	args, err = BuildQueryArgs("GRAPH.QUERY", "myGraph", "MATCH (n) RETURN n", nil, 5000, true)
+	if err != nil {
+		t.Fatalf("BuildQueryArgs with timeout failed: %v", err)
+	}
 
 	foundTimeout := false
 	for _, arg := range args {
@@ -81,6 +108,27 @@ func TestBuildQueryArgs(t *testing.T) {
 	}
 }
 
+func TestDurationToMillis(t *testing.T) {
+	tests := []struct {
+		input    time.Duration
+		expected int
+	}{
+		{0, 0},
+		{-time.Second, 0},
+		{time.Nanosecond, 1},
+		{time.Millisecond, 1},
+		{1500 * time.Microsecond, 2},
+		{2 * time.Second, 2000},
+	}
+
+	for _, tc := range tests {
+		result := DurationToMillis(tc.input)
+		if result != tc.expected {
+			t.Errorf("DurationToMillis(%v) = %d, expected %d", tc.input, result, tc.expected)
+		}
+	}
+}
+
 func TestBuildConstraintArgs(t *testing.T) {
 	args := BuildConstraintArgs("CREATE", "myGraph", "UNIQUE", "NODE", "Person", []string{"name", "email"})
 
@@ -118,4 +166,7 @@ func TestBuildIndexArgs(t *testing.T) {
 	if len(args) < 10 {
 		t.Errorf("Expected at least 10 args for vector index, got %d", len(args))
 	}
+	if args[5] != "dimension" || args[7] != "similarity" {
+		t.Errorf("Expected options in sorted order, got %v", args[5:9])
+	}
 }
@@ -0,0 +1,358 @@
+package proto
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxEncodeDepth bounds nesting so that cyclic values fail instead of overflowing the stack.
+const maxEncodeDepth = 64
+
+// Marshaler is implemented by values that encode themselves as a Cypher literal.
+type Marshaler interface {
+	MarshalCypher() (string, error)
+}
+
+var (
+	marshalerType = reflect.TypeOf((*Marshaler)(nil)).Elem()
+	timeType      = reflect.TypeOf(time.Time{})
+)
+
+// localDateTimeLayout is the ISO 8601 layout accepted by Cypher's localdatetime().
+const localDateTimeLayout = "2006-01-02T15:04:05.999999999"
+
+// ValueToString converts a parameter value to its Cypher string representation.
+//
+// Besides the basic Go types it accepts any slice, array or map with string
+// keys, structs (using their `falkordb` or `json` field tags), pointers and
+// values implementing Marshaler. A time.Time is encoded as a localdatetime()
+// in UTC. Unsupported types return an error.
+func ValueToString(param interface{}) (string, error) {
+	var b strings.Builder
+	if err := encodeValue(&b, reflect.ValueOf(param), 0); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func encodeValue(b *strings.Builder, v reflect.Value, depth int) error {
+	if depth > maxEncodeDepth {
+		return fmt.Errorf("value nested deeper than %d levels", maxEncodeDepth)
+	}
+	if !v.IsValid() {
+		b.WriteString("null")
+		return nil
+	}
+
+	if v.Type().Implements(marshalerType) {
+		if (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && v.IsNil() {
+			b.WriteString("null")
+			return nil
+		}
+		s, err := v.Interface().(Marshaler).MarshalCypher()
+		if err != nil {
+			return fmt.Errorf("marshal %s: %w", v.Type(), err)
+		}
+		b.WriteString(s)
+		return nil
+	}
+
+	if v.Type() == timeType {
+		b.WriteString(FormatLocalDateTime(v.Interface().(time.Time)))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		b.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := v.Uint()
+		if u > math.MaxInt64 {
+			return fmt.Errorf("integer %d overflows int64", u)
+		}
+		b.WriteString(strconv.FormatUint(u, 10))
+	case reflect.Float32, reflect.Float64:
+		f, err := formatFloat(v.Float(), v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		b.WriteString(f)
+	case reflect.String:
+		quoted, err := QuoteString(v.String())
+		if err != nil {
+			return err
+		}
+		b.WriteString(quoted)
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			b.WriteString("null")
+			return nil
+		}
+		return encodeValue(b, v.Elem(), depth+1)
+	case reflect.Slice:
+		if v.IsNil() {
+			b.WriteString("null")
+			return nil
+		}
+		return encodeList(b, v, depth)
+	case reflect.Array:
+		return encodeList(b, v, depth)
+	case reflect.Map:
+		if v.IsNil() {
+			b.WriteString("null")
+			return nil
+		}
+		return encodeMap(b, v, depth)
+	case reflect.Struct:
+		return encodeStruct(b, v, depth)
+	default:
+		return fmt.Errorf("unsupported parameter type %s", v.Type())
+	}
+	return nil
+}
+
+func encodeList(b *strings.Builder, v reflect.Value, depth int) error {
+	b.WriteByte('[')
+	for i := 0; i < v.Len(); i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if err := encodeValue(b, v.Index(i), depth+1); err != nil {
+			return err
+		}
+	}
+	b.WriteByte(']')
+	return nil
+}
+
+func encodeMap(b *strings.Builder, v reflect.Value, depth int) error {
+	if v.Type().Key().Kind() != reflect.String {
+		return fmt.Errorf("unsupported map key type %s", v.Type().Key())
+	}
+
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+
+	b.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if err := writeKey(b, key.String()); err != nil {
+			return err
+		}
+		b.WriteByte(':')
+		if err := encodeValue(b, v.MapIndex(key), depth+1); err != nil {
+			return err
+		}
+	}
+	b.WriteByte('}')
+	return nil
+}
+
+func encodeStruct(b *strings.Builder, v reflect.Value, depth int) error {
+	b.WriteByte('{')
+	n := 0
+	err := encodeStructFields(b, v, depth, &n)
+	b.WriteByte('}')
+	return err
+}
+
+// encodeStructFields writes the fields of v, flattening untagged embedded structs.
+func encodeStructFields(b *strings.Builder, v reflect.Value, depth int, n *int) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, omitEmpty, skip := fieldName(field)
+		if skip {
+			continue
+		}
+
+		fv := v.Field(i)
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					continue
+				}
+				ft, fv = ft.Elem(), fv.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if err := encodeStructFields(b, fv, depth, n); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if omitEmpty && fv.IsZero() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		if *n > 0 {
+			b.WriteByte(',')
+		}
+		*n++
+		if err := writeKey(b, name); err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		b.WriteByte(':')
+		if err := encodeValue(b, fv, depth+1); err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+// fieldName returns the property name from the `falkordb` tag, falling back
+// to the `json` tag, and whether the field is omitted when empty or skipped.
+func fieldName(field reflect.StructField) (name string, omitEmpty, skip bool) {
+	tag, ok := field.Tag.Lookup("falkordb")
+	if !ok {
+		tag = field.Tag.Get("json")
+	}
+	if tag == "-" {
+		return "", false, true
+	}
+
+	name, opts, _ := strings.Cut(tag, ",")
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty, false
+}
+
+// FormatLocalDateTime encodes t, converted to UTC, as a Cypher localdatetime() call.
+func FormatLocalDateTime(t time.Time) string {
+	// The formatted time is plain ASCII, so quoting cannot fail.
+	quoted, _ := QuoteString(t.UTC().Format(localDateTimeLayout))
+	return "localdatetime(" + quoted + ")"
+}
+
+// writeKey writes a map key, quoting it when it is not a plain identifier.
+func writeKey(b *strings.Builder, key string) error {
+	if IsIdentifier(key) {
+		b.WriteString(key)
+		return nil
+	}
+	quoted, err := QuoteIdentifier(key)
+	if err != nil {
+		return fmt.Errorf("map key %q: %w", key, err)
+	}
+	b.WriteString(quoted)
+	return nil
+}
+
+// IsIdentifier reports whether s is a plain Cypher identifier that needs no
+// quoting: an ASCII letter or underscore followed by letters, digits or underscores.
+func IsIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		case '0' <= c && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// reservedWords are the Cypher keywords that cannot be used as unquoted
+// variable names, in upper case.
+var reservedWords = map[string]bool{
+	"ALL": true, "AND": true, "AS": true, "ASC": true, "ASCENDING": true,
+	"BY": true, "CALL": true, "CASE": true, "CONSTRAINT": true, "CONTAINS": true,
+	"CREATE": true, "DELETE": true, "DESC": true, "DESCENDING": true, "DETACH": true,
+	"DISTINCT": true, "DO": true, "DROP": true, "ELSE": true, "END": true,
+	"ENDS": true, "EXISTS": true, "FALSE": true, "FOR": true, "FOREACH": true,
+	"IN": true, "INDEX": true, "IS": true, "LIMIT": true, "MANDATORY": true,
+	"MATCH": true, "MERGE": true, "NOT": true, "NULL": true, "OF": true,
+	"ON": true, "OPTIONAL": true, "OR": true, "ORDER": true, "REMOVE": true,
+	"REQUIRE": true, "RETURN": true, "SCALAR": true, "SET": true, "SKIP": true,
+	"STARTS": true, "THEN": true, "TRUE": true, "UNION": true, "UNIQUE": true,
+	"UNWIND": true, "WHEN": true, "WHERE": true, "WITH": true, "XOR": true,
+	"YIELD": true,
+}
+
+// IsReservedWord reports whether s is a Cypher keyword, in any case, that
+// must be backtick-quoted to be used as a variable or alias.
+func IsReservedWord(s string) bool {
+	return reservedWords[strings.ToUpper(s)]
+}
+
+// QuoteIdentifier returns name as a backtick-quoted Cypher identifier,
+// doubling any backticks it contains. It fails for empty names, invalid
+// UTF-8 and NUL characters, none of which the server can represent.
+func QuoteIdentifier(name string) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("empty identifier")
+	}
+	if err := checkText(name); err != nil {
+		return "", err
+	}
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`", nil
+}
+
+// QuoteString returns s as a double-quoted Cypher string literal.
+// It fails for invalid UTF-8 and NUL characters.
+func QuoteString(s string) (string, error) {
+	if err := checkText(s); err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' || s[i] == '"' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('"')
+	return b.String(), nil
+}
+
+// checkText rejects text that cannot be embedded in a query.
+func checkText(s string) error {
+	if !utf8.ValidString(s) {
+		return fmt.Errorf("invalid UTF-8 in %q", s)
+	}
+	if strings.IndexByte(s, 0) >= 0 {
+		return fmt.Errorf("NUL character in %q", s)
+	}
+	return nil
+}
+
+// formatFloat formats f so that Cypher always parses it as a float,
+// even when it has no fractional part. NaN and infinities have no
+// Cypher literal and are rejected.
+func formatFloat(f float64, bits int) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fmt.Errorf("unsupported float value %v", f)
+	}
+	s := strings.Replace(strconv.FormatFloat(f, 'g', -1, bits), "e+", "e", 1)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s, nil
+}
@@ -0,0 +1,285 @@
+package proto
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+type status string
+
+type address struct {
+	City string `falkordb:"city"`
+	Zip  string `json:"zip,omitempty"`
+}
+
+type person struct {
+	Name    string   `falkordb:"name"`
+	Age     int      `falkordb:"age"`
+	Email   string   `falkordb:"email,omitempty"`
+	Secret  string   `falkordb:"-"`
+	Tags    []string `json:"tags"`
+	Address *address `falkordb:"address"`
+	private int
+}
+
+type base struct {
+	ID int64 `falkordb:"id"`
+}
+
+type derived struct {
+	base
+	Label string `falkordb:"label"`
+}
+
+type upper string
+
+func (u upper) MarshalCypher() (string, error) {
+	quoted, err := QuoteString(string(u))
+	return "toUpper(" + quoted + ")", err
+}
+
+type failing struct{}
+
+func (failing) MarshalCypher() (string, error) {
+	return "", errors.New("boom")
+}
+
+type node struct {
+	Next *node
+}
+
+func TestValueToStringReflection(t *testing.T) {
+	var nilPtr *int
+	seven := 7
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected string
+	}{
+		{"string slice", []string{"a", "b"}, `["a","b"]`},
+		{"int64 slice", []int64{1, 2}, "[1,2]"},
+		{"array", [2]bool{true, false}, "[true,false]"},
+		{"nested slice", [][]int{{1}, {2, 3}}, "[[1],[2,3]]"},
+		{"empty slice", []string{}, "[]"},
+		{"nil slice", []string(nil), "null"},
+		{"string map", map[string]string{"b": "2", "a": "1"}, `{a:"1",b:"2"}`},
+		{"quoted map key", map[string]int{"first name": 1, "a`b": 2}, "{`a``b`:2,`first name`:1}"},
+		{"unicode string", "héllo\nworld", "\"héllo\nworld\""},
+		{"named string", status("active"), `"active"`},
+		{"pointer", &seven, "7"},
+		{"nil pointer", nilPtr, "null"},
+		{"float with fraction", 1.5, "1.5"},
+		{"integral float", 3.0, "3.0"},
+		{"large float", 1e21, "1e21"},
+		{"float32", float32(0.25), "0.25"},
+		{"uint", uint32(7), "7"},
+		{"struct", person{
+			Name:    "Alice",
+			Age:     30,
+			Secret:  "x",
+			Tags:    []string{"go"},
+			Address: &address{City: "NYC"},
+		}, `{name:"Alice",age:30,tags:["go"],address:{city:"NYC"}}`},
+		{"embedded struct", derived{base: base{ID: 1}, Label: "x"}, `{id:1,label:"x"}`},
+		{"marshaler", upper("abc"), `toUpper("abc")`},
+		{"marshaler in list", []interface{}{upper("a"), 1}, `[toUpper("a"),1]`},
+		{"time", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), `localdatetime("2024-01-02T03:04:05")`},
+		{"time with fraction", time.Date(2024, 1, 2, 3, 4, 5, 500000000, time.UTC), `localdatetime("2024-01-02T03:04:05.5")`},
+		{"time in zone", time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("X", 3600)), `localdatetime("2024-01-02T02:04:05")`},
+		{"time pointer", &time.Time{}, `localdatetime("0001-01-01T00:00:00")`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := ValueToString(tc.input)
+			if err != nil {
+				t.Fatalf("ValueToString failed: %v", err)
+			}
+			if result != tc.expected {
+				t.Errorf("got %s, expected %s", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestValueToStringUnsupported(t *testing.T) {
+	cyclic := &node{}
+	cyclic.Next = cyclic
+
+	tests := []struct {
+		name  string
+		input interface{}
+	}{
+		{"func", func() {}},
+		{"channel", make(chan int)},
+		{"complex", complex(1, 2)},
+		{"int keyed map", map[int]string{1: "a"}},
+		{"nested unsupported", []interface{}{1, make(chan int)}},
+		{"uint overflow", uint64(1 << 63)},
+		{"failing marshaler", failing{}},
+		{"cycle", cyclic},
+		{"NaN", math.NaN()},
+		{"infinity", math.Inf(1)},
+		{"float32 infinity", float32(math.Inf(-1))},
+		{"invalid UTF-8", "bad\xff"},
+		{"NUL character", "a\x00b"},
+		{"invalid map key", map[string]int{"\xff": 1}},
+		{"empty map key", map[string]int{"": 1}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ValueToString(tc.input); err == nil {
+				t.Error("Expected error")
+			}
+		})
+	}
+}
+
+func TestParamsToStringDeterministic(t *testing.T) {
+	params := map[string]interface{}{
+		"z": 1, "a": "x", "m": []int{1, 2}, "b": map[string]int{"y": 1, "x": 2},
+		"c": 1.5, "d": true, "e": nil, "f": "f", "g": 7,
+	}
+
+	first, err := paramsToString(params)
+	if err != nil {
+		t.Fatalf("paramsToString failed: %v", err)
+	}
+	expected := `a="x" b={x:2,y:1} c=1.5 d=true e=null f="f" g=7 m=[1,2] z=1`
+	if first != expected {
+		t.Errorf("got %s, expected %s", first, expected)
+	}
+
+	for i := 0; i < 50; i++ {
+		if again, _ := paramsToString(params); again != first {
+			t.Fatalf("Non-deterministic output: %s != %s", again, first)
+		}
+	}
+}
+
+func TestParamsToStringInvalidNames(t *testing.T) {
+	for _, name := range []string{"", "1abc", "a b", "a=1 b", "x`", "naïve"} {
+		if _, err := paramsToString(map[string]interface{}{name: 1}); err == nil {
+			t.Errorf("Expected error for parameter name %q", name)
+		}
+	}
+}
+
+// unquoteString reverses QuoteString for the fuzz tests.
+func unquoteString(t *testing.T, quoted string) string {
+	t.Helper()
+	if len(quoted) < 2 || quoted[0] != '"' || quoted[len(quoted)-1] != '"' {
+		t.Fatalf("Not a quoted string: %q", quoted)
+	}
+
+	var b strings.Builder
+	inner := quoted[1 : len(quoted)-1]
+	for i := 0; i < len(inner); i++ {
+		c := inner[i]
+		switch {
+		case c == '\\':
+			i++
+			if i == len(inner) || (inner[i] != '\\' && inner[i] != '"') {
+				t.Fatalf("Invalid escape in %q", quoted)
+			}
+			c = inner[i]
+		case c == '"':
+			t.Fatalf("Unescaped quote in %q", quoted)
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+func FuzzQuoteString(f *testing.F) {
+	for _, seed := range []string{"", "hello", `a"b`, `a\b`, `\"`, "line\nbreak", "bad\xff", "nul\x00", "`)-[:X]->(n) //"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		quoted, err := QuoteString(s)
+		valid := utf8.ValidString(s) && !strings.Contains(s, "\x00")
+		if !valid {
+			if err == nil {
+				t.Fatalf("Expected error for %q", s)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("QuoteString(%q) failed: %v", s, err)
+		}
+		if got := unquoteString(t, quoted); got != s {
+			t.Fatalf("Round trip of %q produced %q", s, got)
+		}
+	})
+}
+
+func FuzzQuoteIdentifier(f *testing.F) {
+	for _, seed := range []string{"name", "first name", "a`b", "``", "x`) DETACH DELETE n //", "bad\xff"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		quoted, err := QuoteIdentifier(s)
+		if s == "" || !utf8.ValidString(s) || strings.Contains(s, "\x00") {
+			if err == nil {
+				t.Fatalf("Expected error for %q", s)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("QuoteIdentifier(%q) failed: %v", s, err)
+		}
+
+		inner := quoted[1 : len(quoted)-1]
+		if quoted[0] != '`' || quoted[len(quoted)-1] != '`' {
+			t.Fatalf("Not backtick-quoted: %q", quoted)
+		}
+		// Every backtick inside must be part of a doubled pair
+		if strings.Contains(strings.ReplaceAll(inner, "``", ""), "`") {
+			t.Fatalf("Unescaped backtick in %q", quoted)
+		}
+		if strings.ReplaceAll(inner, "``", "`") != s {
+			t.Fatalf("Round trip of %q produced %q", s, inner)
+		}
+	})
+}
+
+func FuzzValueToString(f *testing.F) {
+	f.Add("key", "value", 1.5, int64(3))
+	f.Add("a b", "x\"y", math.NaN(), int64(-1))
+
+	f.Fuzz(func(t *testing.T, key, value string, fl float64, n int64) {
+		param := map[string]interface{}{key: []interface{}{value, fl, n}}
+		first, err := ValueToString(param)
+		if err != nil {
+			return
+		}
+		second, err := ValueToString(param)
+		if err != nil || first != second {
+			t.Fatalf("Non-deterministic encoding: %q vs %q (%v)", first, second, err)
+		}
+		if math.IsNaN(fl) || math.IsInf(fl, 0) {
+			t.Fatalf("Expected error for special float %v", fl)
+		}
+	})
+}
+
+func TestIsReservedWord(t *testing.T) {
+	for _, word := range []string{"MATCH", "match", "Return", "limit", "yield"} {
+		if !IsReservedWord(word) {
+			t.Errorf("Expected %q to be reserved", word)
+		}
+	}
+	for _, word := range []string{"n", "person", "matches", "count", ""} {
+		if IsReservedWord(word) {
+			t.Errorf("Expected %q not to be reserved", word)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package proto
+
+import "strings"
+
+// ErrorKind classifies a FalkorDB server error message.
+type ErrorKind int
+
+const (
+	ErrorKindUnknown ErrorKind = iota
+	ErrorKindSyntax
+	ErrorKindUnknownFunction
+	ErrorKindTimeout
+	ErrorKindConstraint
+	ErrorKindUnknownGraph
+	ErrorKindWrongType
+	ErrorKindReadOnly
+)
+
+// errorPatterns maps lower-cased message fragments to their error kind.
+// Patterns are checked in order, so more specific fragments come first.
+var errorPatterns = []struct {
+	fragment string
+	kind     ErrorKind
+}{
+	{"is to be executed only on read-only queries", ErrorKindReadOnly},
+	{"unknown function", ErrorKindUnknownFunction},
+	{"query timed out", ErrorKindTimeout},
+	{"constraint violation", ErrorKindConstraint},
+	{"invalid graph operation on empty key", ErrorKindUnknownGraph},
+	{"graph does not exist", ErrorKindUnknownGraph},
+	{"wrongtype", ErrorKindWrongType},
+	{"type mismatch", ErrorKindWrongType},
+	{"invalid input", ErrorKindSyntax},
+	{"syntax error", ErrorKindSyntax},
+	{"not defined", ErrorKindSyntax},
+	{"errctx", ErrorKindSyntax},
+}
+
+// ClassifyError returns the kind of a FalkorDB server error message.
+func ClassifyError(msg string) ErrorKind {
+	lower := strings.ToLower(msg)
+	for _, p := range errorPatterns {
+		if strings.Contains(lower, p.fragment) {
+			return p.kind
+		}
+	}
+	return ErrorKindUnknown
+}
@@ -0,0 +1,28 @@
+package proto
+
+import "testing"
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		msg      string
+		expected ErrorKind
+	}{
+		{"errMsg: Invalid input 'T': expected MATCH line: 1, column: 1, offset: 0 errCtx: THIS IS NOT CYPHER errCtxOffset: 0", ErrorKindSyntax},
+		{"Variable `x` not defined", ErrorKindSyntax},
+		{"Unknown function 'nope'", ErrorKindUnknownFunction},
+		{"Query timed out", ErrorKindTimeout},
+		{"unique constraint violation on node of type Person", ErrorKindConstraint},
+		{"mandatory constraint violation: node with label Person missing property name", ErrorKindConstraint},
+		{"ERR Invalid graph operation on empty key", ErrorKindUnknownGraph},
+		{"WRONGTYPE Operation against a key holding the wrong kind of value", ErrorKindWrongType},
+		{"Type mismatch: expected Integer but was String", ErrorKindWrongType},
+		{"graph.RO_QUERY is to be executed only on read-only queries", ErrorKindReadOnly},
+		{"something else entirely", ErrorKindUnknown},
+	}
+
+	for _, tc := range tests {
+		if result := ClassifyError(tc.msg); result != tc.expected {
+			t.Errorf("ClassifyError(%q) = %d, expected %d", tc.msg, result, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package proto
+
+// MatchGlob reports whether name matches a Redis-style glob pattern, as
+// used by KEYS and SCAN: '*' matches any run of characters, '?' any single
+// character, "[abc]", "[^abc]" and "[a-z]" a character class, and '\'
+// escapes the next character. Unlike path.Match, '/' is not special.
+func MatchGlob(pattern, name string) bool {
+	p, s := []rune(pattern), []rune(name)
+	for len(p) > 0 {
+		switch p[0] {
+		case '*':
+			for len(p) > 1 && p[1] == '*' {
+				p = p[1:]
+			}
+			if len(p) == 1 {
+				return true
+			}
+			for i := 0; i <= len(s); i++ {
+				if MatchGlob(string(p[1:]), string(s[i:])) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(s) == 0 {
+				return false
+			}
+			s = s[1:]
+			p = p[1:]
+		case '[':
+			if len(s) == 0 {
+				return false
+			}
+			rest, ok := matchClass(p[1:], s[0])
+			if !ok {
+				return false
+			}
+			p = rest
+			s = s[1:]
+		default:
+			if p[0] == '\\' && len(p) > 1 {
+				p = p[1:]
+			}
+			if len(s) == 0 || p[0] != s[0] {
+				return false
+			}
+			s = s[1:]
+			p = p[1:]
+		}
+	}
+	return len(s) == 0
+}
+
+// matchClass matches c against the character class at the start of p,
+// just after its '[', and returns the pattern after the closing ']'.
+// An unterminated class extends to the end of the pattern.
+func matchClass(p []rune, c rune) ([]rune, bool) {
+	negate := len(p) > 0 && p[0] == '^'
+	if negate {
+		p = p[1:]
+	}
+
+	matched := false
+	for len(p) > 0 && p[0] != ']' {
+		switch {
+		case p[0] == '\\' && len(p) > 1:
+			matched = matched || p[1] == c
+			p = p[2:]
+		case len(p) > 2 && p[1] == '-' && p[2] != ']':
+			lo, hi := p[0], p[2]
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+			matched = matched || (c >= lo && c <= hi)
+			p = p[3:]
+		default:
+			matched = matched || p[0] == c
+			p = p[1:]
+		}
+	}
+	if len(p) > 0 {
+		p = p[1:]
+	}
+	return p, matched != negate
+}
@@ -0,0 +1,36 @@
+package proto
+
+import "testing"
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		expected      bool
+	}{
+		{"", "", true},
+		{"*", "anything", true},
+		{"*", "", true},
+		{"social*", "social", true},
+		{"social*", "social_backup", true},
+		{"social*", "antisocial", false},
+		{"*_backup", "social_backup", true},
+		{"user-?", "user-1", true},
+		{"user-?", "user-12", false},
+		{"tenant/*/graph", "tenant/acme/graph", true},
+		{"g[ab]", "ga", true},
+		{"g[ab]", "gc", false},
+		{"g[^ab]", "gc", true},
+		{"g[a-c]x", "gbx", true},
+		{"g[a-c]x", "gdx", false},
+		{`g\*`, "g*", true},
+		{`g\*`, "gx", false},
+		{"a*b*c", "aXbYc", true},
+		{"a*b*c", "aXbY", false},
+		{"grafo-ñ*", "grafo-ñandú", true},
+	}
+	for _, tt := range tests {
+		if got := MatchGlob(tt.pattern, tt.name); got != tt.expected {
+			t.Errorf("MatchGlob(%q, %q) = %v, expected %v", tt.pattern, tt.name, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package proto
+
+import (
+	"strings"
+)
+
+// InfoField is one "key:value" line of an INFO reply.
+type InfoField struct {
+	Section string
+	Key     string
+	Value   string
+}
+
+// ParseInfo splits the text of an INFO reply into fields, in order.
+// Section names come from "# Name" header lines and are lowercased.
+// Blank lines and lines without a separator are ignored.
+func ParseInfo(text string) []InfoField {
+	var (
+		fields  []InfoField
+		section string
+	)
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			section = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(line, "#")))
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		fields = append(fields, InfoField{Section: section, Key: key, Value: value})
+	}
+	return fields
+}
+
+// ParseInfoList splits a comma-separated INFO value such as
+// "keys=1,expires=0,avg_ttl=0" into a map. Items without "=" are ignored.
+func ParseInfoList(value string) map[string]string {
+	items := make(map[string]string)
+	for _, item := range strings.Split(value, ",") {
+		key, val, ok := strings.Cut(item, "=")
+		if !ok {
+			continue
+		}
+		items[key] = val
+	}
+	return items
+}
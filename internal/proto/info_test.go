@@ -0,0 +1,34 @@
+package proto
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseInfo(t *testing.T) {
+	text := "# Server\r\nredis_version:7.2.4\r\nredis_mode:standalone\r\n\r\n" +
+		"# Modules\r\nmodule:name=graph,ver=41202\r\nmodule:name=search,ver=21005\r\n" +
+		"garbage\r\n"
+
+	expected := []InfoField{
+		{Section: "server", Key: "redis_version", Value: "7.2.4"},
+		{Section: "server", Key: "redis_mode", Value: "standalone"},
+		{Section: "modules", Key: "module", Value: "name=graph,ver=41202"},
+		{Section: "modules", Key: "module", Value: "name=search,ver=21005"},
+	}
+	if fields := ParseInfo(text); !reflect.DeepEqual(fields, expected) {
+		t.Errorf("ParseInfo = %+v, expected %+v", fields, expected)
+	}
+
+	if fields := ParseInfo(""); len(fields) != 0 {
+		t.Errorf("Expected no fields, got %+v", fields)
+	}
+}
+
+func TestParseInfoList(t *testing.T) {
+	items := ParseInfoList("keys=10,expires=0,avg_ttl=0,broken")
+	expected := map[string]string{"keys": "10", "expires": "0", "avg_ttl": "0"}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("ParseInfoList = %v, expected %v", items, expected)
+	}
+}
@@ -0,0 +1,78 @@
+package proto
+
+import "fmt"
+
+// ExtractParams returns the names of the $parameters referenced by a Cypher
+// query, in order of first appearance. String literals, quoted identifiers
+// and comments are skipped, so a "$" inside them is not treated as a parameter.
+//
+// Backtick-quoted parameter names such as $`my param` are rejected, because
+// the CYPHER prefix that carries parameter values only accepts identifiers.
+func ExtractParams(query string) ([]string, error) {
+	var names []string
+	seen := make(map[string]bool)
+
+	for i := 0; i < len(query); i++ {
+		switch c := query[i]; {
+		case c == '\'' || c == '"':
+			i = skipQuoted(query, i, c, true)
+		case c == '`':
+			i = skipQuoted(query, i, '`', false)
+		case c == '/' && i+1 < len(query) && query[i+1] == '/':
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			i += 2
+			for i+1 < len(query) && !(query[i] == '*' && query[i+1] == '/') {
+				i++
+			}
+			i++
+		case c == '$':
+			if i+1 < len(query) && query[i+1] == '`' {
+				end := skipQuoted(query, i+1, '`', false)
+				return nil, fmt.Errorf("quoted parameter name %s is not supported, use an identifier", query[i:min(end+1, len(query))])
+			}
+			name, end := paramName(query, i+1)
+			if name != "" && !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+			i = end - 1
+		}
+	}
+	return names, nil
+}
+
+// skipQuoted returns the index of the closing quote of the literal starting
+// at start. Backslash escapes are honored when escapes is set; backtick
+// identifiers instead escape the quote by doubling it.
+func skipQuoted(query string, start int, quote byte, escapes bool) int {
+	for i := start + 1; i < len(query); i++ {
+		switch {
+		case escapes && query[i] == '\\':
+			i++
+		case query[i] == quote:
+			if !escapes && i+1 < len(query) && query[i+1] == quote {
+				i++
+				continue
+			}
+			return i
+		}
+	}
+	return len(query)
+}
+
+// paramName reads an identifier parameter name starting at start and
+// returns it with the index after it.
+func paramName(query string, start int) (string, int) {
+	end := start
+	for end < len(query) && isIdentChar(query[end]) {
+		end++
+	}
+	return query[start:end], end
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
@@ -0,0 +1,48 @@
+package proto
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractParams(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{"MATCH (n) RETURN n", nil},
+		{"MATCH (n {name: $name}) WHERE n.age > $age RETURN n", []string{"name", "age"}},
+		{"RETURN $a + $b + $a", []string{"a", "b"}},
+		{"RETURN $0, $1", []string{"0", "1"}},
+		{"RETURN 'cost: $5' AS s, $real", []string{"real"}},
+		{`RETURN "it\"s $not" AS s, $yes`, []string{"yes"}},
+		{"RETURN n.`$prop`, $p", []string{"p"}},
+		{"RETURN 1 // $commented\n, $after", []string{"after"}},
+		{"RETURN /* $hidden */ $shown", []string{"shown"}},
+		{"RETURN $", nil},
+		{"RETURN 'unterminated $x", nil},
+	}
+
+	for _, tc := range tests {
+		result, err := ExtractParams(tc.query)
+		if err != nil {
+			t.Errorf("ExtractParams(%q) failed: %v", tc.query, err)
+			continue
+		}
+		if !reflect.DeepEqual(result, tc.expected) {
+			t.Errorf("ExtractParams(%q) = %v, expected %v", tc.query, result, tc.expected)
+		}
+	}
+}
+
+func TestExtractParamsQuotedName(t *testing.T) {
+	for _, query := range []string{"RETURN $`odd name`", "RETURN $a, $`x``y`", "RETURN $`open"} {
+		if _, err := ExtractParams(query); err == nil {
+			t.Errorf("ExtractParams(%q) succeeded, expected an error", query)
+		}
+	}
+	// A backtick that is not part of a parameter is still fine
+	if _, err := ExtractParams("RETURN n.`$x`, $y"); err != nil {
+		t.Errorf("ExtractParams failed for quoted property: %v", err)
+	}
+}
@@ -2,7 +2,10 @@ package proto
 
 import (
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // ValueType represents the type of a value in a FalkorDB result.
@@ -106,6 +109,75 @@ func ParseSlowLogResult(result interface{}) ([]map[string]interface{}, error) {
 	return entries, nil
 }
 
+// ParsePairs parses a reply of alternating keys and values, or a RESP3 map,
+// into a map keyed by the string form of each key.
+func ParsePairs(result interface{}) (map[string]interface{}, error) {
+	switch v := result.(type) {
+	case map[interface{}]interface{}:
+		pairs := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			pairs[ToString(key)] = value
+		}
+		return pairs, nil
+	case map[string]interface{}:
+		return v, nil
+	case []interface{}:
+		if len(v)%2 != 0 {
+			return nil, fmt.Errorf("unexpected odd number of elements in key/value reply: %d", len(v))
+		}
+		pairs := make(map[string]interface{}, len(v)/2)
+		for i := 0; i < len(v); i += 2 {
+			pairs[ToString(v[i])] = v[i+1]
+		}
+		return pairs, nil
+	default:
+		return nil, fmt.Errorf("unexpected key/value reply format: %T", result)
+	}
+}
+
+// ParseStatistics splits query metadata lines such as "Nodes created: 1"
+// into a map of statistic name to raw value. Lines without a separator are ignored.
+func ParseStatistics(metadata []string) map[string]string {
+	stats := make(map[string]string, len(metadata))
+	for _, line := range metadata {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		stats[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return stats
+}
+
+// ParseExecutionTime parses an execution time statistic such as
+// "0.3 milliseconds". A missing unit is treated as milliseconds.
+func ParseExecutionTime(value string) (time.Duration, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("empty execution time")
+	}
+
+	amount, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid execution time %q: %w", value, err)
+	}
+
+	unit := time.Millisecond
+	if len(fields) > 1 {
+		switch fields[1] {
+		case "milliseconds", "millisecond", "ms":
+			unit = time.Millisecond
+		case "microseconds", "microsecond", "us":
+			unit = time.Microsecond
+		case "seconds", "second", "s":
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("unknown execution time unit %q", fields[1])
+		}
+	}
+	return time.Duration(math.Round(amount * float64(unit))), nil
+}
+
 // Helper functions for type conversion
 
 func toStringSlice(v interface{}) ([]string, error) {
@@ -172,6 +244,45 @@ func ToFloat64(v interface{}) float64 {
 	}
 }
 
+// ToTime converts a temporal value, in seconds since the Unix epoch, to a UTC time.Time.
+// Fractional seconds are preserved.
+func ToTime(v interface{}) time.Time {
+	sec, nsec := toSeconds(v)
+	return time.Unix(sec, nsec).UTC()
+}
+
+// ToDuration converts a duration value, in seconds, to a time.Duration.
+func ToDuration(v interface{}) time.Duration {
+	sec, nsec := toSeconds(v)
+	return time.Duration(sec)*time.Second + time.Duration(nsec)
+}
+
+// toSeconds splits a possibly fractional number of seconds into whole
+// seconds and nanoseconds.
+func toSeconds(v interface{}) (int64, int64) {
+	switch val := v.(type) {
+	case int, int64:
+		return ToInt64(val), 0
+	case string:
+		if i, err := strconv.ParseInt(val, 10, 64); err == nil {
+			return i, 0
+		}
+	}
+	f := ToFloat64(v)
+	sec := math.Trunc(f)
+	return int64(sec), int64(math.Round((f - sec) * 1e9))
+}
+
+// ToFloat32 converts an interface{} to float32.
+// Strings are parsed at 32-bit precision to avoid double rounding.
+func ToFloat32(v interface{}) float32 {
+	if s, ok := v.(string); ok {
+		f, _ := strconv.ParseFloat(s, 32)
+		return float32(f)
+	}
+	return float32(ToFloat64(v))
+}
+
 // ToString converts an interface{} to string.
 func ToString(v interface{}) string {
 	if v == nil {
@@ -182,3 +293,29 @@ func ToString(v interface{}) string {
 	}
 	return fmt.Sprint(v)
 }
+
+// ParseQueryInfo parses a GRAPH.INFO RunningQueries or WaitingQueries reply:
+// a section title followed by a list of queries, each a key/value reply.
+func ParseQueryInfo(result interface{}) ([]map[string]interface{}, error) {
+	arr, ok := result.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected info result format: %T", result)
+	}
+
+	var queries []map[string]interface{}
+	for _, section := range arr {
+		items, ok := section.([]interface{})
+		if !ok {
+			// Section titles such as "# Running queries"
+			continue
+		}
+		for _, item := range items {
+			fields, err := ParsePairs(item)
+			if err != nil {
+				return nil, err
+			}
+			queries = append(queries, fields)
+		}
+	}
+	return queries, nil
+}
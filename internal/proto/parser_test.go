@@ -1,6 +1,9 @@
 package proto
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestToInt(t *testing.T) {
 	tests := []struct {
@@ -62,6 +65,26 @@ func TestToFloat64(t *testing.T) {
 	}
 }
 
+func TestToFloat32(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected float32
+	}{
+		{float64(0.1), 0.1},
+		{"0.1", 0.1},
+		{"3.4028235e38", 3.4028235e38},
+		{int64(2), 2},
+		{nil, 0},
+	}
+
+	for _, tc := range tests {
+		result := ToFloat32(tc.input)
+		if result != tc.expected {
+			t.Errorf("ToFloat32(%v) = %v, expected %v", tc.input, result, tc.expected)
+		}
+	}
+}
+
 func TestToString(t *testing.T) {
 	tests := []struct {
 		input    interface{}
@@ -158,3 +181,139 @@ func TestValueTypes(t *testing.T) {
 		}
 	}
 }
+
+func TestParseStatistics(t *testing.T) {
+	stats := ParseStatistics([]string{
+		"Nodes created: 2",
+		"Cached execution: 1",
+		"Query internal execution time: 0.3 milliseconds",
+		"garbage",
+	})
+
+	expected := map[string]string{
+		"Nodes created":                 "2",
+		"Cached execution":              "1",
+		"Query internal execution time": "0.3 milliseconds",
+	}
+	if len(stats) != len(expected) {
+		t.Fatalf("Expected %d statistics, got %d: %v", len(expected), len(stats), stats)
+	}
+	for k, v := range expected {
+		if stats[k] != v {
+			t.Errorf("ParseStatistics()[%q] = %q, expected %q", k, stats[k], v)
+		}
+	}
+}
+
+func TestParseExecutionTime(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+	}{
+		{"0.3 milliseconds", 300 * time.Microsecond},
+		{"2 milliseconds", 2 * time.Millisecond},
+		{"1.5", 1500 * time.Microsecond},
+		{"1 seconds", time.Second},
+	}
+
+	for _, tc := range tests {
+		result, err := ParseExecutionTime(tc.input)
+		if err != nil {
+			t.Errorf("ParseExecutionTime(%q) failed: %v", tc.input, err)
+			continue
+		}
+		if result != tc.expected {
+			t.Errorf("ParseExecutionTime(%q) = %v, expected %v", tc.input, result, tc.expected)
+		}
+	}
+
+	for _, input := range []string{"", "fast", "1 fortnights"} {
+		if _, err := ParseExecutionTime(input); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+func TestToTime(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected time.Time
+	}{
+		{int64(0), time.Unix(0, 0).UTC()},
+		{int64(1709251200), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"1709251200", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{1709251200.5, time.Date(2024, 3, 1, 0, 0, 0, 500000000, time.UTC)},
+		{int64(-86400), time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tests {
+		result := ToTime(tc.input)
+		if !result.Equal(tc.expected) || result.Location() != time.UTC {
+			t.Errorf("ToTime(%v) = %v, expected %v", tc.input, result, tc.expected)
+		}
+	}
+}
+
+func TestToDuration(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected time.Duration
+	}{
+		{int64(0), 0},
+		{int64(90), 90 * time.Second},
+		{int64(-5), -5 * time.Second},
+		{1.25, 1250 * time.Millisecond},
+	}
+
+	for _, tc := range tests {
+		if result := ToDuration(tc.input); result != tc.expected {
+			t.Errorf("ToDuration(%v) = %v, expected %v", tc.input, result, tc.expected)
+		}
+	}
+}
+
+func TestParsePairs(t *testing.T) {
+	expected := map[string]interface{}{"a": int64(1), "b": "x"}
+
+	for _, input := range []interface{}{
+		[]interface{}{"a", int64(1), "b", "x"},
+		map[interface{}]interface{}{"a": int64(1), "b": "x"},
+	} {
+		pairs, err := ParsePairs(input)
+		if err != nil {
+			t.Fatalf("ParsePairs(%v) failed: %v", input, err)
+		}
+		if len(pairs) != 2 || pairs["a"] != expected["a"] || pairs["b"] != expected["b"] {
+			t.Errorf("ParsePairs(%v) = %v, expected %v", input, pairs, expected)
+		}
+	}
+
+	for _, input := range []interface{}{[]interface{}{"a"}, "a"} {
+		if _, err := ParsePairs(input); err == nil {
+			t.Errorf("Expected error for %v", input)
+		}
+	}
+}
+
+func TestParseQueryInfo(t *testing.T) {
+	reply := []interface{}{
+		"# Running queries",
+		[]interface{}{
+			[]interface{}{"Graph name", "social", "Query", "MATCH (n) RETURN n"},
+			map[interface{}]interface{}{"Graph name": "other"},
+		},
+	}
+
+	queries, err := ParseQueryInfo(reply)
+	if err != nil {
+		t.Fatalf("ParseQueryInfo failed: %v", err)
+	}
+	if len(queries) != 2 || queries[0]["Query"] != "MATCH (n) RETURN n" || queries[1]["Graph name"] != "other" {
+		t.Errorf("Unexpected queries: %v", queries)
+	}
+
+	queries, err = ParseQueryInfo([]interface{}{"# Waiting queries", []interface{}{}})
+	if err != nil || len(queries) != 0 {
+		t.Errorf("Expected no queries, got %v, %v", queries, err)
+	}
+}
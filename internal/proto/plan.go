@@ -0,0 +1,74 @@
+package proto
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// planIndent is the indentation FalkorDB uses for each level of a plan.
+const planIndent = "    "
+
+// PlanStep is a single operation line of an EXPLAIN or PROFILE reply.
+type PlanStep struct {
+	Depth int
+	Name  string
+	Args  string
+
+	// Profiled is set when the line carries PROFILE statistics.
+	Profiled        bool
+	RecordsProduced int64
+	ExecutionTimeMs float64
+}
+
+// ParsePlan parses the lines of an EXPLAIN or PROFILE reply. Each line holds
+// an operation indented by its depth in the tree, with its arguments and
+// profile statistics separated by " | ".
+func ParsePlan(lines []string) ([]PlanStep, error) {
+	steps := make([]PlanStep, 0, len(lines))
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		var step PlanStep
+		for strings.HasPrefix(line, planIndent) {
+			line = line[len(planIndent):]
+			step.Depth++
+		}
+
+		parts := strings.Split(strings.TrimSpace(line), " | ")
+		if last := parts[len(parts)-1]; len(parts) > 1 && strings.HasPrefix(last, "Records produced: ") {
+			records, ms, err := parseProfileStats(last)
+			if err != nil {
+				return nil, fmt.Errorf("invalid plan line %q: %w", line, err)
+			}
+			step.Profiled = true
+			step.RecordsProduced = records
+			step.ExecutionTimeMs = ms
+			parts = parts[:len(parts)-1]
+		}
+
+		step.Name = parts[0]
+		step.Args = strings.Join(parts[1:], " | ")
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// parseProfileStats parses "Records produced: N, Execution time: X ms".
+func parseProfileStats(s string) (int64, float64, error) {
+	records, timing, ok := strings.Cut(strings.TrimPrefix(s, "Records produced: "), ", Execution time: ")
+	if !ok {
+		return 0, 0, fmt.Errorf("missing execution time")
+	}
+	n, err := strconv.ParseInt(records, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	ms, err := strconv.ParseFloat(strings.TrimSuffix(timing, " ms"), 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return n, ms, nil
+}
@@ -0,0 +1,54 @@
+package proto
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePlan(t *testing.T) {
+	lines := []string{
+		"Results",
+		"    Project",
+		"        Conditional Traverse | (a:Person)-[:KNOWS|LIKES]->(b)",
+		"            Node By Label Scan | (a:Person)",
+	}
+
+	steps, err := ParsePlan(lines)
+	if err != nil {
+		t.Fatalf("ParsePlan failed: %v", err)
+	}
+
+	expected := []PlanStep{
+		{Depth: 0, Name: "Results"},
+		{Depth: 1, Name: "Project"},
+		{Depth: 2, Name: "Conditional Traverse", Args: "(a:Person)-[:KNOWS|LIKES]->(b)"},
+		{Depth: 3, Name: "Node By Label Scan", Args: "(a:Person)"},
+	}
+	if !reflect.DeepEqual(steps, expected) {
+		t.Errorf("ParsePlan = %+v, expected %+v", steps, expected)
+	}
+}
+
+func TestParsePlanProfile(t *testing.T) {
+	lines := []string{
+		"Results | Records produced: 2, Execution time: 0.001900 ms",
+		"    Node By Label Scan | (n:Person) | Records produced: 2, Execution time: 0.012300 ms",
+	}
+
+	steps, err := ParsePlan(lines)
+	if err != nil {
+		t.Fatalf("ParsePlan failed: %v", err)
+	}
+
+	expected := []PlanStep{
+		{Depth: 0, Name: "Results", Profiled: true, RecordsProduced: 2, ExecutionTimeMs: 0.0019},
+		{Depth: 1, Name: "Node By Label Scan", Args: "(n:Person)", Profiled: true, RecordsProduced: 2, ExecutionTimeMs: 0.0123},
+	}
+	if !reflect.DeepEqual(steps, expected) {
+		t.Errorf("ParsePlan = %+v, expected %+v", steps, expected)
+	}
+
+	if _, err := ParsePlan([]string{"Results | Records produced: x, Execution time: 1 ms"}); err == nil {
+		t.Error("Expected error for malformed profile statistics")
+	}
+}
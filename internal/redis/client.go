@@ -3,6 +3,10 @@ package redis
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -11,10 +15,28 @@ import (
 // Client is the interface for Redis client operations used by FalkorDB.
 type Client interface {
 	Do(ctx context.Context, args ...interface{}) *redis.Cmd
+	// DoReplica sends a read-only command to a replica serving key,
+	// falling back to the primary when no replica is known.
+	DoReplica(ctx context.Context, key string, args ...interface{}) *redis.Cmd
+	// ReadTargets returns the nodes that can serve read-only commands for
+	// key: a replica first, when one is known, and the primary last.
+	ReadTargets(ctx context.Context, key string) []Target
+	// Subscribe subscribes to pub/sub channels on a dedicated connection.
+	Subscribe(ctx context.Context, channels ...string) *redis.PubSub
+	// Nodes returns every server behind the client, sorted by address.
+	// A single-node client returns one primary.
+	Nodes(ctx context.Context) ([]Node, error)
 	Close() error
 	Ping(ctx context.Context) *redis.StatusCmd
 }
 
+// Node is a single server behind a Client.
+type Node struct {
+	Addr    string
+	Primary bool
+	Do      Target
+}
+
 // Options configures the Redis connection.
 type Options struct {
 	Addr         string
@@ -79,6 +101,22 @@ func (c *singleClient) Do(ctx context.Context, args ...interface{}) *redis.Cmd {
 	return c.client.Do(ctx, args...)
 }
 
+func (c *singleClient) DoReplica(ctx context.Context, key string, args ...interface{}) *redis.Cmd {
+	return c.client.Do(ctx, args...)
+}
+
+func (c *singleClient) ReadTargets(ctx context.Context, key string) []Target {
+	return []Target{c.client.Do}
+}
+
+func (c *singleClient) Subscribe(ctx context.Context, channels ...string) *redis.PubSub {
+	return c.client.Subscribe(ctx, channels...)
+}
+
+func (c *singleClient) Nodes(ctx context.Context) ([]Node, error) {
+	return []Node{{Addr: c.client.Options().Addr, Primary: true, Do: c.client.Do}}, nil
+}
+
 func (c *singleClient) Close() error {
 	return c.client.Close()
 }
@@ -115,6 +153,77 @@ func (c *clusterClient) Do(ctx context.Context, args ...interface{}) *redis.Cmd
 	return c.client.Do(ctx, args...)
 }
 
+// DoReplica runs the command on a replica of the slot owning key.
+func (c *clusterClient) DoReplica(ctx context.Context, key string, args ...interface{}) *redis.Cmd {
+	node, err := c.client.SlaveForKey(ctx, key)
+	if err != nil {
+		return c.client.Do(ctx, args...)
+	}
+	return readOnlyTarget(node)(ctx, args...)
+}
+
+func (c *clusterClient) ReadTargets(ctx context.Context, key string) []Target {
+	master, err := c.client.MasterForKey(ctx, key)
+	if err != nil {
+		return []Target{c.client.Do}
+	}
+	replica, err := c.client.SlaveForKey(ctx, key)
+	if err != nil || replica.Options().Addr == master.Options().Addr {
+		return []Target{master.Do}
+	}
+	return []Target{readOnlyTarget(replica), master.Do}
+}
+
+// readOnlyTarget sends commands to a replica. READONLY is pipelined ahead of
+// each command because the cluster client only enables it on its
+// connections when configured for replica reads globally.
+func readOnlyTarget(node *redis.Client) Target {
+	return func(ctx context.Context, args ...interface{}) *redis.Cmd {
+		var cmd *redis.Cmd
+		_, _ = node.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.ReadOnly(ctx)
+			cmd = pipe.Do(ctx, args...)
+			return nil
+		})
+		return cmd
+	}
+}
+
+func (c *clusterClient) Subscribe(ctx context.Context, channels ...string) *redis.PubSub {
+	return c.client.Subscribe(ctx, channels...)
+}
+
+// Nodes returns the primaries and replicas known to the cluster client.
+// Replica commands are sent with READONLY.
+func (c *clusterClient) Nodes(ctx context.Context) ([]Node, error) {
+	var (
+		mu    sync.Mutex
+		nodes []Node
+	)
+	collect := func(primary bool) func(ctx context.Context, node *redis.Client) error {
+		return func(ctx context.Context, node *redis.Client) error {
+			target := node.Do
+			if !primary {
+				target = readOnlyTarget(node)
+			}
+			mu.Lock()
+			nodes = append(nodes, Node{Addr: node.Options().Addr, Primary: primary, Do: target})
+			mu.Unlock()
+			return nil
+		}
+	}
+
+	if err := c.client.ForEachMaster(ctx, collect(true)); err != nil {
+		return nil, err
+	}
+	if err := c.client.ForEachSlave(ctx, collect(false)); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Addr < nodes[j].Addr })
+	return nodes, nil
+}
+
 func (c *clusterClient) Close() error {
 	return c.client.Close()
 }
@@ -190,3 +299,36 @@ func parseMasterAddr(masters interface{}) string {
 	}
 	return ""
 }
+
+// IsServerError reports whether err is an error reply returned by the server,
+// as opposed to a network, context or client-side error.
+func IsServerError(err error) bool {
+	if err == nil || errors.Is(err, redis.Nil) {
+		return false
+	}
+	var rerr redis.Error
+	return errors.As(err, &rerr)
+}
+
+// retryablePrefixes are server error prefixes for transient conditions.
+var retryablePrefixes = []string{"LOADING ", "BUSY ", "TRYAGAIN ", "CLUSTERDOWN ", "MASTERDOWN ", "READONLY "}
+
+// IsRetryable reports whether err is a transient failure worth retrying:
+// a network error or a server reply signalling a temporary condition.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, redis.Nil) ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if !IsServerError(err) {
+		return true
+	}
+
+	msg := err.Error()
+	for _, prefix := range retryablePrefixes {
+		if strings.HasPrefix(msg, prefix) {
+			return true
+		}
+	}
+	return false
+}
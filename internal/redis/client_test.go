@@ -0,0 +1,61 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// serverError mimics an error reply from the server.
+type serverError string
+
+func (e serverError) Error() string { return string(e) }
+func (serverError) RedisError()     {}
+
+func TestIsServerError(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected bool
+	}{
+		{nil, false},
+		{redis.Nil, false},
+		{io.EOF, false},
+		{context.Canceled, false},
+		{serverError("ERR Invalid input"), true},
+		{fmt.Errorf("wrapped: %w", serverError("ERR Invalid input")), true},
+	}
+
+	for _, tc := range tests {
+		if result := IsServerError(tc.err); result != tc.expected {
+			t.Errorf("IsServerError(%v) = %v, expected %v", tc.err, result, tc.expected)
+		}
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected bool
+	}{
+		{nil, false},
+		{redis.Nil, false},
+		{context.Canceled, false},
+		{context.DeadlineExceeded, false},
+		{io.EOF, true},
+		{errors.New("dial tcp: connection refused"), true},
+		{serverError("LOADING Redis is loading the dataset in memory"), true},
+		{serverError("TRYAGAIN Multiple keys request during rehashing of slot"), true},
+		{serverError("ERR Invalid input"), false},
+		{serverError("Query timed out"), false},
+	}
+
+	for _, tc := range tests {
+		if result := IsRetryable(tc.err); result != tc.expected {
+			t.Errorf("IsRetryable(%v) = %v, expected %v", tc.err, result, tc.expected)
+		}
+	}
+}
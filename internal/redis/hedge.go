@@ -0,0 +1,59 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Target sends a command to one node.
+type Target func(ctx context.Context, args ...interface{}) *redis.Cmd
+
+// DoHedged sends args to the first target and, if no reply arrives within
+// delay, sends them again to the second target (or the first again if there
+// is only one). The first successful reply wins and the other request's
+// context is cancelled. If the first request fails before the hedge is due,
+// its error is returned without sending the hedge.
+func DoHedged(ctx context.Context, targets []Target, delay time.Duration, args ...interface{}) (interface{}, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type reply struct {
+		val interface{}
+		err error
+	}
+	replies := make(chan reply, 2)
+	send := func(target Target) {
+		val, err := target(ctx, args...).Result()
+		replies <- reply{val, err}
+	}
+
+	go send(targets[0])
+	pending := 1
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	var firstErr error
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			go send(targets[1%len(targets)])
+			pending++
+		case r := <-replies:
+			pending--
+			if r.err == nil {
+				return r.val, nil
+			}
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			if pending == 0 {
+				return nil, firstErr
+			}
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// fakeTarget replies with val or err after delay, or fails when its
+// context is cancelled first. It counts the requests it receives.
+func fakeTarget(delay time.Duration, val interface{}, err error, calls *int32, cancelled chan<- struct{}) Target {
+	return func(ctx context.Context, args ...interface{}) *redis.Cmd {
+		atomic.AddInt32(calls, 1)
+		cmd := redis.NewCmd(ctx, args...)
+		select {
+		case <-time.After(delay):
+			if err != nil {
+				cmd.SetErr(err)
+			} else {
+				cmd.SetVal(val)
+			}
+		case <-ctx.Done():
+			cmd.SetErr(ctx.Err())
+			if cancelled != nil {
+				close(cancelled)
+			}
+		}
+		return cmd
+	}
+}
+
+func TestDoHedgedFastReply(t *testing.T) {
+	var first, second int32
+	targets := []Target{
+		fakeTarget(0, "first", nil, &first, nil),
+		fakeTarget(0, "second", nil, &second, nil),
+	}
+
+	val, err := DoHedged(context.Background(), targets, 100*time.Millisecond, "PING")
+	if err != nil || val != "first" {
+		t.Fatalf("DoHedged = %v, %v", val, err)
+	}
+	if second != 0 {
+		t.Error("Hedge was sent for a fast reply")
+	}
+}
+
+func TestDoHedgedSlowReply(t *testing.T) {
+	var first, second int32
+	cancelled := make(chan struct{})
+	targets := []Target{
+		fakeTarget(time.Second, "first", nil, &first, cancelled),
+		fakeTarget(0, "second", nil, &second, nil),
+	}
+
+	start := time.Now()
+	val, err := DoHedged(context.Background(), targets, 10*time.Millisecond, "PING")
+	if err != nil || val != "second" {
+		t.Fatalf("DoHedged = %v, %v", val, err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Hedged request took %v", elapsed)
+	}
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Error("Slow request was not cancelled")
+	}
+}
+
+func TestDoHedgedErrors(t *testing.T) {
+	errFirst := errors.New("first failed")
+	errSecond := errors.New("second failed")
+	var first, second int32
+
+	// A failure before the hedge is due is returned as is
+	targets := []Target{
+		fakeTarget(0, nil, errFirst, &first, nil),
+		fakeTarget(0, "second", nil, &second, nil),
+	}
+	if _, err := DoHedged(context.Background(), targets, 50*time.Millisecond, "PING"); !errors.Is(err, errFirst) {
+		t.Errorf("Expected first error, got %v", err)
+	}
+
+	// A failure after the hedge was sent waits for the hedge
+	targets = []Target{
+		fakeTarget(30*time.Millisecond, nil, errFirst, &first, nil),
+		fakeTarget(50*time.Millisecond, "second", nil, &second, nil),
+	}
+	if val, err := DoHedged(context.Background(), targets, 10*time.Millisecond, "PING"); err != nil || val != "second" {
+		t.Errorf("DoHedged = %v, %v", val, err)
+	}
+
+	targets = []Target{
+		fakeTarget(30*time.Millisecond, nil, errFirst, &first, nil),
+		fakeTarget(50*time.Millisecond, nil, errSecond, &second, nil),
+	}
+	if _, err := DoHedged(context.Background(), targets, 10*time.Millisecond, "PING"); !errors.Is(err, errFirst) {
+		t.Errorf("Expected first error, got %v", err)
+	}
+}
+
+func TestDoHedgedSingleTarget(t *testing.T) {
+	var calls int32
+	var slow atomic.Bool
+	slow.Store(true)
+	target := func(ctx context.Context, args ...interface{}) *redis.Cmd {
+		delay := time.Duration(0)
+		if slow.Swap(false) {
+			delay = time.Second
+		}
+		return fakeTarget(delay, "ok", nil, &calls, nil)(ctx, args...)
+	}
+
+	if val, err := DoHedged(context.Background(), []Target{target}, 10*time.Millisecond, "PING"); err != nil || val != "ok" {
+		t.Fatalf("DoHedged = %v, %v", val, err)
+	}
+	if atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("Expected the hedge to be sent to the same target, got %d calls", calls)
+	}
+}
+
+func TestDoHedgedCallerCancelled(t *testing.T) {
+	// Targets that ignore cancellation, like a node stuck until ReadTimeout
+	stuck := func(ctx context.Context, args ...interface{}) *redis.Cmd {
+		time.Sleep(time.Second)
+		return redis.NewCmd(ctx, args...)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := DoHedged(ctx, []Target{stuck, stuck}, 10*time.Millisecond, "PING")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("DoHedged waited %v for targets after cancellation", elapsed)
+	}
+}
@@ -0,0 +1,220 @@
+package falkordb
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// LintRule identifies a plan problem reported by LintPlan.
+type LintRule string
+
+// Lint rules.
+const (
+	// LintAllNodeScan flags scans of every node in the graph.
+	LintAllNodeScan LintRule = "all-node-scan"
+
+	// LintUnindexedFilter flags label scans whose results are filtered on
+	// properties, which an index would answer directly.
+	LintUnindexedFilter LintRule = "unindexed-filter"
+
+	// LintCartesianProduct flags joins of disconnected patterns.
+	LintCartesianProduct LintRule = "cartesian-product"
+
+	// LintUnboundedTraversal flags variable-length traversals without an upper bound.
+	LintUnboundedTraversal LintRule = "unbounded-traversal"
+
+	// LintMissingLimit flags queries that return rows without a LIMIT or an
+	// aggregation to bound them. It is not checked by default.
+	LintMissingLimit LintRule = "missing-limit"
+)
+
+// defaultLintRules are the rules LintPlan checks when none are given.
+var defaultLintRules = []LintRule{
+	LintAllNodeScan, LintUnindexedFilter, LintCartesianProduct, LintUnboundedTraversal,
+}
+
+// LintFinding is a problem found in an execution plan.
+type LintFinding struct {
+	Rule      LintRule
+	Operation *Operation
+	Message   string
+
+	// Suggestion describes how to fix the problem.
+	Suggestion string
+
+	// Label and Property are set for LintUnindexedFilter when the filtered
+	// property is known, naming the range index to create.
+	Label    string
+	Property string
+}
+
+// String returns the finding as a single line.
+func (f LintFinding) String() string {
+	return fmt.Sprintf("%s: %s (%s)", f.Rule, f.Message, f.Suggestion)
+}
+
+// Lint explains a query and reports operations that will not scale with the
+// size of the graph. It does not execute the query.
+func (g *Graph) Lint(ctx context.Context, query string, options ...QueryOption) ([]LintFinding, error) {
+	plan, err := g.Explain(ctx, query, options...)
+	if err != nil {
+		return nil, err
+	}
+	return LintPlan(query, plan), nil
+}
+
+var (
+	// scanPattern matches the arguments of a label scan, such as (n:Person).
+	scanPattern = regexp.MustCompile(`^\(([^:()]*):([^:()]+)\)$`)
+
+	// unboundedHops matches open-ended hop ranges such as *, *2.. and *1..INF.
+	unboundedHops = regexp.MustCompile(`\*(\d*\.\.(INF)?)?\]`)
+
+	// whereClause matches a WHERE clause up to the next clause keyword.
+	whereClause = regexp.MustCompile(`(?is)\bWHERE\b(.*?)(\b(RETURN|WITH|MATCH|OPTIONAL|ORDER|SKIP|LIMIT|CREATE|MERGE|SET|DELETE|DETACH|REMOVE|UNWIND|CALL|UNION)\b|$)`)
+
+	// stringOperator matches STARTS WITH and ENDS WITH, which must not end a WHERE clause.
+	stringOperator = regexp.MustCompile(`(?i)\b(STARTS|ENDS)\s+WITH\b`)
+)
+
+// LintPlan inspects the execution plan of query for the given rules, or for
+// full scans, unindexed filters, Cartesian products and unbounded traversals
+// if no rules are given. The query text is used to name the properties a
+// filter reads.
+func LintPlan(query string, plan *ExecutionPlan, rules ...LintRule) []LintFinding {
+	if plan == nil || plan.Root == nil {
+		return nil
+	}
+	if len(rules) == 0 {
+		rules = defaultLintRules
+	}
+	enabled := make(map[LintRule]bool, len(rules))
+	for _, rule := range rules {
+		enabled[rule] = true
+	}
+
+	var findings []LintFinding
+	limited := false
+
+	var walk func(op, parent *Operation)
+	walk = func(op, parent *Operation) {
+		switch {
+		case op.Name == "Limit" || op.Name == "Aggregate":
+			limited = true
+		case op.Name == "All Node Scan":
+			findings = append(findings, LintFinding{
+				Rule:       LintAllNodeScan,
+				Operation:  op,
+				Message:    fmt.Sprintf("%s scans every node in the graph", op.Args),
+				Suggestion: "add a label to the node pattern",
+			})
+		case op.Name == "Node By Label Scan" && parent != nil && parent.Name == "Filter":
+			findings = append(findings, lintFilteredScan(query, op)...)
+		case op.Name == "Cartesian Product":
+			findings = append(findings, LintFinding{
+				Rule:       LintCartesianProduct,
+				Operation:  op,
+				Message:    "disconnected patterns are joined by a Cartesian product",
+				Suggestion: "connect the patterns with a relationship or match them in separate queries",
+			})
+		case strings.Contains(op.Name, "Variable Length") && unboundedHops.MatchString(op.Args):
+			findings = append(findings, LintFinding{
+				Rule:       LintUnboundedTraversal,
+				Operation:  op,
+				Message:    fmt.Sprintf("%s has no maximum number of hops", op.Args),
+				Suggestion: "set an upper bound such as *1..5",
+			})
+		}
+		for _, child := range op.Children {
+			walk(child, op)
+		}
+	}
+	walk(plan.Root, nil)
+
+	if !limited && plan.Root.Name == "Results" {
+		findings = append(findings, LintFinding{
+			Rule:       LintMissingLimit,
+			Operation:  plan.Root,
+			Message:    "the number of rows returned is unbounded",
+			Suggestion: "add a LIMIT clause",
+		})
+	}
+
+	kept := findings[:0]
+	for _, f := range findings {
+		if enabled[f.Rule] {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
+
+// lintFilteredScan reports a label scan that feeds a filter, with one finding
+// per filtered property of the scanned variable.
+func lintFilteredScan(query string, op *Operation) []LintFinding {
+	m := scanPattern.FindStringSubmatch(op.Args)
+	if m == nil {
+		return nil
+	}
+	variable, label := m[1], strings.Trim(m[2], "`")
+
+	props := filteredProperties(query, variable)
+	if len(props) == 0 {
+		return []LintFinding{{
+			Rule:       LintUnindexedFilter,
+			Operation:  op,
+			Message:    fmt.Sprintf("every :%s node is scanned and then filtered", label),
+			Suggestion: fmt.Sprintf("create a range index on the filtered properties of :%s", label),
+			Label:      label,
+		}}
+	}
+
+	findings := make([]LintFinding, 0, len(props))
+	for _, prop := range props {
+		findings = append(findings, LintFinding{
+			Rule:       LintUnindexedFilter,
+			Operation:  op,
+			Message:    fmt.Sprintf("every :%s node is scanned to filter on %s.%s", label, variable, prop),
+			Suggestion: fmt.Sprintf("CreateNodeRangeIndex(ctx, %q, %q)", label, prop),
+			Label:      label,
+			Property:   prop,
+		})
+	}
+	return findings
+}
+
+// filteredProperties returns the properties of variable that query compares
+// in WHERE clauses or inline property maps, in sorted order.
+func filteredProperties(query, variable string) []string {
+	if variable == "" {
+		return nil
+	}
+	v := regexp.QuoteMeta(variable)
+	found := make(map[string]bool)
+
+	access := regexp.MustCompile(`\b` + v + `\.(\w+)`)
+	clauses := stringOperator.ReplaceAllString(query, "${1}_WITH")
+	for _, where := range whereClause.FindAllStringSubmatch(clauses, -1) {
+		for _, m := range access.FindAllStringSubmatch(where[1], -1) {
+			found[m[1]] = true
+		}
+	}
+
+	inline := regexp.MustCompile(`\(\s*` + v + `\s*:[^{)]*\{([^}]*)\}`)
+	key := regexp.MustCompile(`(\w+)\s*:`)
+	for _, m := range inline.FindAllStringSubmatch(query, -1) {
+		for _, k := range key.FindAllStringSubmatch(m[1], -1) {
+			found[k[1]] = true
+		}
+	}
+
+	props := make([]string, 0, len(found))
+	for p := range found {
+		props = append(props, p)
+	}
+	sort.Strings(props)
+	return props
+}
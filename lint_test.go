@@ -0,0 +1,131 @@
+package falkordb
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func mustParsePlan(t *testing.T, lines ...string) *ExecutionPlan {
+	t.Helper()
+	plan, err := parsePlan(lines)
+	if err != nil {
+		t.Fatalf("parsePlan failed: %v", err)
+	}
+	return plan
+}
+
+func TestLintPlan(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		plan     []string
+		expected []LintFinding
+	}{
+		{
+			name:  "all node scan",
+			query: "MATCH (n) RETURN n",
+			plan:  []string{"Results", "    Project", "        All Node Scan | (n)"},
+			expected: []LintFinding{
+				{Rule: LintAllNodeScan},
+			},
+		},
+		{
+			name:  "filtered label scan",
+			query: "MATCH (p:Person {name: $name}) WHERE p.age > 30 AND p.email STARTS WITH 'a' RETURN p.nickname",
+			plan:  []string{"Results", "    Project", "        Filter", "            Node By Label Scan | (p:Person)"},
+			expected: []LintFinding{
+				{Rule: LintUnindexedFilter, Label: "Person", Property: "age"},
+				{Rule: LintUnindexedFilter, Label: "Person", Property: "email"},
+				{Rule: LintUnindexedFilter, Label: "Person", Property: "name"},
+			},
+		},
+		{
+			name:  "index scan",
+			query: "MATCH (p:Person) WHERE p.age > 30 RETURN p",
+			plan:  []string{"Results", "    Project", "        Node By Index Scan | (p:Person)"},
+		},
+		{
+			name:  "unfiltered label scan",
+			query: "MATCH (p:Person) RETURN p",
+			plan:  []string{"Results", "    Project", "        Node By Label Scan | (p:Person)"},
+		},
+		{
+			name:  "cartesian product",
+			query: "MATCH (a:A), (b:B) RETURN a, b",
+			plan: []string{
+				"Results", "    Project", "        Cartesian Product",
+				"            Node By Label Scan | (a:A)", "            Node By Label Scan | (b:B)",
+			},
+			expected: []LintFinding{{Rule: LintCartesianProduct}},
+		},
+		{
+			name:  "unbounded traversal",
+			query: "MATCH (a:A)-[*]->(b) RETURN b",
+			plan: []string{
+				"Results", "    Project",
+				"        Conditional Variable Length Traverse | (a)-[@anon_0*1..INF]->(b)",
+				"            Node By Label Scan | (a:A)",
+			},
+			expected: []LintFinding{{Rule: LintUnboundedTraversal}},
+		},
+		{
+			name:  "bounded traversal",
+			query: "MATCH (a:A)-[*1..3]->(b) RETURN b",
+			plan: []string{
+				"Results", "    Project",
+				"        Conditional Variable Length Traverse | (a)-[@anon_0*1..3]->(b)",
+				"            Node By Label Scan | (a:A)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings := LintPlan(tt.query, mustParsePlan(t, tt.plan...))
+
+			var got []LintFinding
+			for _, f := range findings {
+				if f.Operation == nil || f.Message == "" || f.Suggestion == "" {
+					t.Errorf("Incomplete finding: %+v", f)
+				}
+				got = append(got, LintFinding{Rule: f.Rule, Label: f.Label, Property: f.Property})
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("LintPlan = %+v, expected %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLintSuggestion(t *testing.T) {
+	plan := mustParsePlan(t, "Results", "    Filter", "        Node By Label Scan | (p:Person)")
+
+	findings := LintPlan("MATCH (p:Person) WHERE p.age > 1 RETURN p", plan)
+	if len(findings) != 1 || findings[0].Suggestion != `CreateNodeRangeIndex(ctx, "Person", "age")` {
+		t.Errorf("Unexpected findings: %v", findings)
+	}
+
+	findings = LintPlan("MATCH (p:Person) WHERE size(labels(p)) > 1 RETURN p", plan)
+	if len(findings) != 1 || findings[0].Property != "" || findings[0].Label != "Person" {
+		t.Errorf("Unexpected findings: %v", findings)
+	}
+}
+
+func TestGraphLint(t *testing.T) {
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		return []interface{}{"Results", "    Project", "        All Node Scan | (n)"}, nil
+	}}
+	graph := newTestGraph(client)
+
+	findings, err := graph.Lint(context.Background(), "MATCH (n) RETURN n")
+	if err != nil {
+		t.Fatalf("Lint failed: %v", err)
+	}
+	if len(findings) != 1 || findings[0].Rule != LintAllNodeScan {
+		t.Errorf("Unexpected findings: %v", findings)
+	}
+	if client.count("GRAPH.EXPLAIN") != 1 || client.count("GRAPH.QUERY") != 0 {
+		t.Errorf("Lint should only explain the query: %v", client.commands)
+	}
+}
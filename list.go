@@ -0,0 +1,117 @@
+package falkordb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+	"github.com/flancast90/falkordb-go/internal/redis"
+)
+
+// GraphListing is a graph and the node that owns it.
+type GraphListing struct {
+	Name string
+
+	// Node is the address of the primary holding the graph.
+	Node string
+}
+
+// List returns the names of all graphs in the database, sorted by name.
+// In cluster mode every primary is asked and the results are merged.
+func (db *FalkorDB) List(ctx context.Context) ([]string, error) {
+	listings, err := db.ListGraphs(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	graphs := make([]string, len(listings))
+	for i, l := range listings {
+		graphs[i] = l.Name
+	}
+	return graphs, nil
+}
+
+// ListGraphs returns the graphs whose names match pattern, with the node
+// owning each one, sorted by name. The pattern uses Redis glob syntax
+// ('*', '?', "[a-z]"); an empty pattern matches every graph.
+//
+// In cluster mode GRAPH.LIST is sent to every primary. A graph reported by
+// more than one primary, as can happen while a slot is migrating, is
+// listed once with the first node by address.
+//
+// Example:
+//
+//	listings, _ := db.ListGraphs(ctx, "tenant-*")
+//	for _, l := range listings {
+//		fmt.Println(l.Name, "on", l.Node)
+//	}
+func (db *FalkorDB) ListGraphs(ctx context.Context, pattern string) ([]GraphListing, error) {
+	nodes, err := db.client.Nodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var primaries []redis.Node
+	for _, node := range nodes {
+		if node.Primary {
+			primaries = append(primaries, node)
+		}
+	}
+
+	names := make([][]string, len(primaries))
+	errs := make([]error, len(primaries))
+	var wg sync.WaitGroup
+	for i, node := range primaries {
+		wg.Add(1)
+		go func(i int, node redis.Node) {
+			defer wg.Done()
+			names[i], errs[i] = listGraphs(ctx, node.Do)
+			if errs[i] != nil && len(primaries) > 1 {
+				errs[i] = fmt.Errorf("falkordb: GRAPH.LIST on %s: %w", node.Addr, errs[i])
+			}
+		}(i, node)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	seen := make(map[string]bool)
+	listings := []GraphListing{}
+	for i, node := range primaries {
+		for _, name := range names[i] {
+			if seen[name] || (pattern != "" && !proto.MatchGlob(pattern, name)) {
+				continue
+			}
+			seen[name] = true
+			listings = append(listings, GraphListing{Name: name, Node: node.Addr})
+		}
+	}
+	sort.Slice(listings, func(i, j int) bool { return listings[i].Name < listings[j].Name })
+	return listings, nil
+}
+
+func listGraphs(ctx context.Context, do redis.Target) ([]string, error) {
+	result, err := do(ctx, "GRAPH.LIST").Result()
+	if err != nil {
+		return nil, wrapError(err, "", "")
+	}
+
+	arr, ok := result.([]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	graphs := make([]string, 0, len(arr))
+	for _, g := range arr {
+		if name, ok := g.(string); ok {
+			graphs = append(graphs, name)
+		}
+	}
+	return graphs, nil
+}
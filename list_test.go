@@ -0,0 +1,93 @@
+package falkordb
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/flancast90/falkordb-go/internal/redis"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// listNode returns a cluster node answering GRAPH.LIST with graphs.
+func listNode(addr string, primary bool, graphs ...interface{}) redis.Node {
+	return redis.Node{Addr: addr, Primary: primary, Do: func(ctx context.Context, args ...interface{}) *goredis.Cmd {
+		cmd := goredis.NewCmd(ctx, args...)
+		cmd.SetVal(graphs)
+		return cmd
+	}}
+}
+
+func TestListAcrossCluster(t *testing.T) {
+	client := &fakeClient{nodes: []redis.Node{
+		listNode("10.0.0.1:6379", true, "social", "tenant-b"),
+		listNode("10.0.0.2:6379", false, "replica-only"),
+		listNode("10.0.0.3:6379", true, "tenant-a", "social"),
+	}}
+	db := &FalkorDB{client: client, opts: &Options{}}
+	ctx := context.Background()
+
+	graphs, err := db.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	expected := []string{"social", "tenant-a", "tenant-b"}
+	if !reflect.DeepEqual(graphs, expected) {
+		t.Errorf("List = %v, expected %v", graphs, expected)
+	}
+
+	listings, err := db.ListGraphs(ctx, "tenant-*")
+	if err != nil {
+		t.Fatalf("ListGraphs failed: %v", err)
+	}
+	expectedListings := []GraphListing{
+		{Name: "tenant-a", Node: "10.0.0.3:6379"},
+		{Name: "tenant-b", Node: "10.0.0.1:6379"},
+	}
+	if !reflect.DeepEqual(listings, expectedListings) {
+		t.Errorf("ListGraphs = %+v, expected %+v", listings, expectedListings)
+	}
+
+	listings, err = db.ListGraphs(ctx, "missing-*")
+	if err != nil || listings == nil || len(listings) != 0 {
+		t.Errorf("Expected an empty, non-nil listing, got %v, %v", listings, err)
+	}
+}
+
+func TestListNodeError(t *testing.T) {
+	failing := redis.Node{Addr: "10.0.0.2:6379", Primary: true, Do: func(ctx context.Context, args ...interface{}) *goredis.Cmd {
+		cmd := goredis.NewCmd(ctx, args...)
+		cmd.SetErr(errors.New("connection refused"))
+		return cmd
+	}}
+	client := &fakeClient{nodes: []redis.Node{listNode("10.0.0.1:6379", true, "social"), failing}}
+	db := &FalkorDB{client: client, opts: &Options{}}
+
+	_, err := db.List(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "10.0.0.2:6379") {
+		t.Errorf("Expected an error naming the failing node, got %v", err)
+	}
+}
+
+func TestListServerError(t *testing.T) {
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		return nil, serverError("ERR unknown command 'GRAPH.LIST'")
+	}}
+	db := &FalkorDB{client: client, opts: &Options{}}
+	ctx := context.Background()
+
+	_, err := db.List(ctx)
+	var fErr *Error
+	if !errors.As(err, &fErr) {
+		t.Fatalf("Expected *Error from List, got %T: %v", err, err)
+	}
+	if !strings.Contains(fErr.Message, "GRAPH.LIST") {
+		t.Errorf("Unexpected message: %q", fErr.Message)
+	}
+
+	if _, err := db.CapacityReport(ctx, 0); !errors.As(err, &fErr) {
+		t.Errorf("Expected *Error from CapacityReport, got %T: %v", err, err)
+	}
+}
@@ -0,0 +1,169 @@
+package falkordb
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+)
+
+// Field names of the GRAPH.MEMORY USAGE reply.
+const (
+	memTotal                 = "total_graph_sz_mb"
+	memLabelMatrices         = "label_matrices_sz_mb"
+	memRelationMatrices      = "relation_matrices_sz_mb"
+	memNodeBlock             = "amortized_node_block_sz_mb"
+	memNodeAttributesByLabel = "amortized_node_attributes_by_label_sz_mb"
+	memUnlabeledAttributes   = "amortized_unlabeled_nodes_attributes_sz_mb"
+	memEdgeBlock             = "amortized_edge_block_sz_mb"
+	memEdgeAttributesByType  = "amortized_edge_attributes_by_type_sz_mb"
+	memIndices               = "indices_sz_mb"
+)
+
+// MemoryReport is the memory used by a graph, as reported by
+// GRAPH.MEMORY USAGE. Sizes are in megabytes. Block and attribute sizes
+// are amortized estimates computed from a sample of entities.
+type MemoryReport struct {
+	TotalMB            float64
+	LabelMatricesMB    float64
+	RelationMatricesMB float64
+	NodeBlockMB        float64
+	EdgeBlockMB        float64
+	IndicesMB          float64
+
+	// NodeAttributesMB is the attribute storage of all nodes: the sum of
+	// NodeAttributesByLabelMB and UnlabeledNodeAttributesMB.
+	NodeAttributesMB          float64
+	NodeAttributesByLabelMB   map[string]float64
+	UnlabeledNodeAttributesMB float64
+
+	// EdgeAttributesMB is the attribute storage of all edges: the sum of
+	// EdgeAttributesByTypeMB.
+	EdgeAttributesMB       float64
+	EdgeAttributesByTypeMB map[string]float64
+
+	// Other holds numeric fields not recognized by this client, keyed by name.
+	Other map[string]float64
+}
+
+// MemoryUsage returns the memory used by the graph. Block and attribute
+// sizes are estimated from samples entities; 0 uses the server default.
+func (g *Graph) MemoryUsage(ctx context.Context, samples int) (*MemoryReport, error) {
+	args := []interface{}{"GRAPH.MEMORY", "USAGE", g.name}
+	if samples > 0 {
+		args = append(args, "SAMPLES", strconv.Itoa(samples))
+	}
+
+	result, err := g.client.Do(ctx, args...).Result()
+	if err != nil {
+		return nil, wrapError(err, g.name, "")
+	}
+	return parseMemoryReport(result)
+}
+
+func parseMemoryReport(result interface{}) (*MemoryReport, error) {
+	fields, err := proto.ParsePairs(result)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &MemoryReport{}
+	for key, value := range fields {
+		switch key {
+		case memTotal:
+			report.TotalMB = proto.ToFloat64(value)
+		case memLabelMatrices:
+			report.LabelMatricesMB = proto.ToFloat64(value)
+		case memRelationMatrices:
+			report.RelationMatricesMB = proto.ToFloat64(value)
+		case memNodeBlock:
+			report.NodeBlockMB = proto.ToFloat64(value)
+		case memEdgeBlock:
+			report.EdgeBlockMB = proto.ToFloat64(value)
+		case memIndices:
+			report.IndicesMB = proto.ToFloat64(value)
+		case memUnlabeledAttributes:
+			report.UnlabeledNodeAttributesMB = proto.ToFloat64(value)
+		case memNodeAttributesByLabel:
+			if report.NodeAttributesByLabelMB, err = parseSizes(value); err != nil {
+				return nil, err
+			}
+		case memEdgeAttributesByType:
+			if report.EdgeAttributesByTypeMB, err = parseSizes(value); err != nil {
+				return nil, err
+			}
+		default:
+			if report.Other == nil {
+				report.Other = make(map[string]float64)
+			}
+			report.Other[key] = proto.ToFloat64(value)
+		}
+	}
+
+	report.NodeAttributesMB = report.UnlabeledNodeAttributesMB
+	for _, mb := range report.NodeAttributesByLabelMB {
+		report.NodeAttributesMB += mb
+	}
+	for _, mb := range report.EdgeAttributesByTypeMB {
+		report.EdgeAttributesMB += mb
+	}
+	return report, nil
+}
+
+// parseSizes parses a nested reply of names and sizes.
+func parseSizes(value interface{}) (map[string]float64, error) {
+	pairs, err := proto.ParsePairs(value)
+	if err != nil {
+		return nil, err
+	}
+	sizes := make(map[string]float64, len(pairs))
+	for name, size := range pairs {
+		sizes[name] = proto.ToFloat64(size)
+	}
+	return sizes, nil
+}
+
+// CapacityReport is the memory used by every graph on the server.
+type CapacityReport struct {
+	// Graphs holds the report of each graph, largest first.
+	Graphs []GraphMemory
+
+	// TotalMB is the memory used by all graphs together.
+	TotalMB float64
+}
+
+// GraphMemory is the memory report of a named graph.
+type GraphMemory struct {
+	Name string
+	*MemoryReport
+}
+
+// CapacityReport returns the memory used by each graph listed by List,
+// estimating block and attribute sizes from samples entities (0 uses the
+// server default). Graphs deleted while the report is built are skipped.
+func (db *FalkorDB) CapacityReport(ctx context.Context, samples int) (*CapacityReport, error) {
+	names, err := db.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &CapacityReport{Graphs: make([]GraphMemory, 0, len(names))}
+	for _, name := range names {
+		usage, err := db.SelectGraph(name).MemoryUsage(ctx, samples)
+		if errors.Is(err, ErrUnknownGraph) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		report.Graphs = append(report.Graphs, GraphMemory{Name: name, MemoryReport: usage})
+		report.TotalMB += usage.TotalMB
+	}
+
+	sort.SliceStable(report.Graphs, func(i, j int) bool {
+		return report.Graphs[i].TotalMB > report.Graphs[j].TotalMB
+	})
+	return report, nil
+}
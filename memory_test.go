@@ -0,0 +1,103 @@
+package falkordb
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func memoryReply(total int64) []interface{} {
+	return []interface{}{
+		"total_graph_sz_mb", total,
+		"label_matrices_sz_mb", int64(2),
+		"relation_matrices_sz_mb", int64(3),
+		"amortized_node_block_sz_mb", int64(4),
+		"amortized_node_attributes_by_label_sz_mb", []interface{}{"Person", int64(5), "City", int64(1)},
+		"amortized_unlabeled_nodes_attributes_sz_mb", int64(1),
+		"amortized_edge_block_sz_mb", int64(6),
+		"amortized_edge_attributes_by_type_sz_mb", []interface{}{"KNOWS", int64(7)},
+		"indices_sz_mb", int64(8),
+		"future_field_sz_mb", int64(9),
+	}
+}
+
+func TestMemoryUsage(t *testing.T) {
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		return memoryReply(40), nil
+	}}
+	graph := newTestGraph(client)
+
+	report, err := graph.MemoryUsage(context.Background(), 100)
+	if err != nil {
+		t.Fatalf("MemoryUsage failed: %v", err)
+	}
+
+	expected := &MemoryReport{
+		TotalMB:                   40,
+		LabelMatricesMB:           2,
+		RelationMatricesMB:        3,
+		NodeBlockMB:               4,
+		EdgeBlockMB:               6,
+		IndicesMB:                 8,
+		NodeAttributesMB:          7,
+		NodeAttributesByLabelMB:   map[string]float64{"Person": 5, "City": 1},
+		UnlabeledNodeAttributesMB: 1,
+		EdgeAttributesMB:          7,
+		EdgeAttributesByTypeMB:    map[string]float64{"KNOWS": 7},
+		Other:                     map[string]float64{"future_field_sz_mb": 9},
+	}
+	if !reflect.DeepEqual(report, expected) {
+		t.Errorf("MemoryUsage = %+v, expected %+v", report, expected)
+	}
+
+	expectedCmd := []interface{}{"GRAPH.MEMORY", "USAGE", "test", "SAMPLES", "100"}
+	if !reflect.DeepEqual(client.commands[0], expectedCmd) {
+		t.Errorf("Unexpected command: %v", client.commands[0])
+	}
+
+	if _, err := graph.MemoryUsage(context.Background(), 0); err != nil {
+		t.Fatalf("MemoryUsage failed: %v", err)
+	}
+	if len(client.commands[1]) != 3 {
+		t.Errorf("Expected no SAMPLES argument, got %v", client.commands[1])
+	}
+}
+
+func TestCapacityReport(t *testing.T) {
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		if args[0] == "GRAPH.LIST" {
+			return []interface{}{"small", "gone", "large"}, nil
+		}
+		switch args[2] {
+		case "small":
+			return memoryReply(10), nil
+		case "large":
+			return memoryReply(90), nil
+		default:
+			return nil, serverError("ERR Invalid graph operation on empty key")
+		}
+	}}
+	db := &FalkorDB{client: client, opts: &Options{}}
+
+	report, err := db.CapacityReport(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("CapacityReport failed: %v", err)
+	}
+	if report.TotalMB != 100 || len(report.Graphs) != 2 {
+		t.Fatalf("Unexpected report: %+v", report)
+	}
+	if report.Graphs[0].Name != "large" || report.Graphs[1].Name != "small" || report.Graphs[0].TotalMB != 90 {
+		t.Errorf("Expected graphs largest first, got %s, %s", report.Graphs[0].Name, report.Graphs[1].Name)
+	}
+
+	client.handler = func(args []interface{}) (interface{}, error) {
+		if args[0] == "GRAPH.LIST" {
+			return []interface{}{"broken"}, nil
+		}
+		return nil, errors.New("connection reset")
+	}
+	if _, err := db.CapacityReport(context.Background(), 0); err == nil {
+		t.Error("Expected error to be returned")
+	}
+}
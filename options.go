@@ -1,6 +1,11 @@
 package falkordb
 
-import "time"
+import (
+	"context"
+	"time"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+)
 
 // Options configures the FalkorDB client connection.
 type Options struct {
@@ -34,6 +39,27 @@ type Options struct {
 	// MinIdleConns is the minimum number of idle connections.
 	// Default: 0
 	MinIdleConns int
+
+	// QueryTimeout is the default server-side timeout for queries
+	// that do not set one in their QueryOptions. It is shorthand for
+	// WithTimeout(QueryTimeout) as the first entry of QueryDefaults.
+	// Default: 0 (no timeout beyond the context deadline)
+	QueryTimeout time.Duration
+
+	// QueryDefaults are applied to every query on every graph selected from
+	// this client. Graph defaults and per-call options override them.
+	QueryDefaults []QueryOption
+
+	// ResultCache, if set, enables a client-side cache of ROQuery results.
+	// Default: nil (no caching)
+	ResultCache *CacheOptions
+
+	// DetectCapabilities makes Connect read the FalkorDB module version, so
+	// helpers that need a newer server fail with ErrUnsupported instead of
+	// a server error. A failed detection does not fail Connect; call
+	// FalkorDB.DetectCapabilities to retry and see the error.
+	// Default: false
+	DetectCapabilities bool
 }
 
 func (o *Options) setDefaults() {
@@ -52,12 +78,254 @@ func (o *Options) setDefaults() {
 }
 
 // QueryOptions configures a Cypher query execution.
+//
+// *QueryOptions implements QueryOption, so it can be passed to
+// Graph.Query alongside functional options such as WithTimeout.
+// Non-zero fields override the defaults of the graph.
 type QueryOptions struct {
 	// Params are the query parameters to pass to the Cypher query.
 	// Parameters are safely escaped and prevent injection attacks.
+	//
+	// Values may be of any basic type, any slice, array or map with string
+	// keys, a pointer to one of those, a struct, or a CypherMarshaler.
+	// Struct fields are named by their `falkordb` tag, falling back to the
+	// `json` tag and then the field name; "-" skips a field and "omitempty"
+	// skips it when zero. Other types fail with ErrInvalidParameter.
 	Params map[string]interface{}
 
 	// Timeout is the query timeout in milliseconds.
 	// A value of 0 means no timeout.
 	Timeout int
+
+	// TimeoutDuration is the query timeout as a time.Duration.
+	// It is used when Timeout is 0 and is rounded up to whole milliseconds.
+	TimeoutDuration time.Duration
+
+	// ReadPreference selects the node that serves read-only queries.
+	// It has no effect on Graph.Query.
+	// Default: ReadPrimary
+	ReadPreference ReadPreference
+
+	// Retry controls how failed queries are retried.
+	// Default: nil (no retries)
+	Retry *RetryPolicy
+
+	// Tags are client-side labels for the query. They are attached to
+	// any *Error the query returns so failures can be attributed in logs.
+	Tags map[string]string
+
+	// Guard, if set, checks the query plan before the query is run.
+	// Default: nil (no checks)
+	Guard *QueryGuard
+
+	// Dedup collapses concurrent identical ROQuery calls, with the same
+	// graph, query text and parameters, into a single server call whose
+	// result is shared by all callers. The server-side timeout, retry
+	// policy and read preference of the first caller apply to the call.
+	// Default: false
+	Dedup bool
+
+	// Hedge, if set, sends a second copy of slow read-only queries to
+	// another node. It has no effect on Graph.Query.
+	// Default: nil (no hedging)
+	Hedge *Hedge
+}
+
+// QueryOption configures a single query execution or the defaults of a graph.
+type QueryOption interface {
+	applyQuery(o *QueryOptions)
+}
+
+// applyQuery merges the non-zero fields of o into dst.
+func (o *QueryOptions) applyQuery(dst *QueryOptions) {
+	if o == nil {
+		return
+	}
+	for k, v := range o.Params {
+		dst.Params[k] = v
+	}
+	switch {
+	case o.Timeout > 0:
+		dst.Timeout = 0
+		dst.TimeoutDuration = time.Duration(o.Timeout) * time.Millisecond
+	case o.TimeoutDuration > 0:
+		dst.Timeout = 0
+		dst.TimeoutDuration = o.TimeoutDuration
+	}
+	if o.ReadPreference != "" {
+		dst.ReadPreference = o.ReadPreference
+	}
+	if o.Retry != nil {
+		dst.Retry = o.Retry
+	}
+	for k, v := range o.Tags {
+		dst.Tags[k] = v
+	}
+	if o.Guard != nil {
+		dst.Guard = o.Guard
+	}
+	if o.Dedup {
+		dst.Dedup = true
+	}
+	if o.Hedge != nil {
+		dst.Hedge = o.Hedge
+	}
+}
+
+type queryOptionFunc func(o *QueryOptions)
+
+func (f queryOptionFunc) applyQuery(o *QueryOptions) {
+	f(o)
+}
+
+// WithParams adds the given parameters to the query.
+func WithParams(params map[string]interface{}) QueryOption {
+	return queryOptionFunc(func(o *QueryOptions) {
+		for k, v := range params {
+			o.Params[k] = v
+		}
+	})
+}
+
+// WithParam adds a single parameter to the query.
+func WithParam(name string, value interface{}) QueryOption {
+	return queryOptionFunc(func(o *QueryOptions) {
+		o.Params[name] = value
+	})
+}
+
+// WithTimeout sets the server-side query timeout.
+func WithTimeout(d time.Duration) QueryOption {
+	return queryOptionFunc(func(o *QueryOptions) {
+		o.Timeout = 0
+		o.TimeoutDuration = d
+	})
+}
+
+// WithReadPreference selects the node that serves read-only queries.
+func WithReadPreference(p ReadPreference) QueryOption {
+	return queryOptionFunc(func(o *QueryOptions) {
+		o.ReadPreference = p
+	})
+}
+
+// WithRetry sets the retry policy for the query.
+func WithRetry(policy RetryPolicy) QueryOption {
+	return queryOptionFunc(func(o *QueryOptions) {
+		o.Retry = &policy
+	})
+}
+
+// WithTag adds a client-side tag to the query.
+func WithTag(key, value string) QueryOption {
+	return queryOptionFunc(func(o *QueryOptions) {
+		o.Tags[key] = value
+	})
+}
+
+// WithGuard checks the query plan with guard before running the query.
+// Pass it to SelectGraph to guard every query on a graph.
+func WithGuard(guard *QueryGuard) QueryOption {
+	return queryOptionFunc(func(o *QueryOptions) {
+		o.Guard = guard
+	})
+}
+
+// WithDedup shares a single server call between concurrent identical
+// read-only queries. Pass it to SelectGraph to enable it for a graph.
+func WithDedup() QueryOption {
+	return queryOptionFunc(func(o *QueryOptions) {
+		o.Dedup = true
+	})
+}
+
+// WithHedge hedges read-only queries with hedge.
+// Pass it to SelectGraph to enable it for a graph.
+func WithHedge(hedge *Hedge) QueryOption {
+	return queryOptionFunc(func(o *QueryOptions) {
+		o.Hedge = hedge
+	})
+}
+
+// resolveQueryOptions applies options, in order, on top of a copy of defaults.
+// The returned maps are never shared with defaults or the options.
+func resolveQueryOptions(defaults *QueryOptions, options []QueryOption) *QueryOptions {
+	resolved := &QueryOptions{
+		Params: make(map[string]interface{}),
+		Tags:   make(map[string]string),
+	}
+	defaults.applyQuery(resolved)
+	for _, opt := range options {
+		if opt != nil {
+			opt.applyQuery(resolved)
+		}
+	}
+	return resolved
+}
+
+// ReadPreference selects which node serves a read-only query.
+type ReadPreference string
+
+const (
+	// ReadPrimary sends read-only queries to the primary.
+	ReadPrimary ReadPreference = "primary"
+
+	// ReadReplica sends read-only queries to a replica of the graph's shard,
+	// falling back to the primary when no replica is known.
+	ReadReplica ReadPreference = "replica"
+)
+
+// RetryPolicy controls how a failed query is retried.
+//
+// Only transient failures are retried: network errors and server replies
+// such as LOADING, BUSY or TRYAGAIN. Query errors reported by FalkorDB and
+// context cancellation are never retried. Note that a write query is not
+// idempotent in general; retry Graph.Query only when that is acceptable.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	MaxAttempts int
+
+	// Backoff is the delay before the first retry. It doubles after each attempt.
+	Backoff time.Duration
+
+	// MaxBackoff caps the delay between attempts. 0 means no cap.
+	MaxBackoff time.Duration
+}
+
+// delay returns the backoff before the given retry (1 for the first retry).
+func (p *RetryPolicy) delay(retry int) time.Duration {
+	d := p.Backoff
+	for i := 1; i < retry; i++ {
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			break
+		}
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d
+}
+
+// serverTimeout returns the TIMEOUT argument, in milliseconds, for a query.
+//
+// If ctx has a deadline, the result is capped at the time remaining so the
+// server stops working on the query once the caller has given up on it.
+func serverTimeout(ctx context.Context, opts *QueryOptions) (int, error) {
+	timeout := opts.TimeoutDuration
+	if opts.Timeout > 0 {
+		timeout = time.Duration(opts.Timeout) * time.Millisecond
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return 0, context.DeadlineExceeded
+		}
+		if timeout <= 0 || remaining < timeout {
+			timeout = remaining
+		}
+	}
+
+	return proto.DurationToMillis(timeout), nil
 }
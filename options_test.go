@@ -0,0 +1,76 @@
+package falkordb
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestResolveQueryOptions(t *testing.T) {
+	defaults := resolveQueryOptions(nil, []QueryOption{
+		&QueryOptions{Timeout: 1000, Tags: map[string]string{"service": "api"}},
+		WithReadPreference(ReadReplica),
+		WithParam("limit", 10),
+	})
+
+	opts := resolveQueryOptions(defaults, []QueryOption{
+		&QueryOptions{Params: map[string]interface{}{"name": "Alice"}},
+		WithTimeout(2 * time.Second),
+		WithTag("endpoint", "search"),
+		nil,
+	})
+
+	if opts.TimeoutDuration != 2*time.Second || opts.Timeout != 0 {
+		t.Errorf("Expected 2s timeout, got %d ms / %v", opts.Timeout, opts.TimeoutDuration)
+	}
+	if opts.ReadPreference != ReadReplica {
+		t.Errorf("Expected replica read preference, got %q", opts.ReadPreference)
+	}
+	if opts.Params["limit"] != 10 || opts.Params["name"] != "Alice" {
+		t.Errorf("Expected merged params, got %v", opts.Params)
+	}
+	if opts.Tags["service"] != "api" || opts.Tags["endpoint"] != "search" {
+		t.Errorf("Expected merged tags, got %v", opts.Tags)
+	}
+
+	// Per-call options must not leak into the defaults
+	if _, ok := defaults.Params["name"]; ok {
+		t.Error("Per-call params leaked into defaults")
+	}
+	if _, ok := defaults.Tags["endpoint"]; ok {
+		t.Error("Per-call tags leaked into defaults")
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := &RetryPolicy{Backoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+
+	expected := []time.Duration{10, 20, 40, 50, 50}
+	for i, want := range expected {
+		if got := policy.delay(i + 1); got != want*time.Millisecond {
+			t.Errorf("delay(%d) = %v, expected %v", i+1, got, want*time.Millisecond)
+		}
+	}
+}
+
+func TestServerTimeout(t *testing.T) {
+	timeout, err := serverTimeout(context.Background(), &QueryOptions{Timeout: 500})
+	if err != nil || timeout != 500 {
+		t.Errorf("Expected 500, got %d (%v)", timeout, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	timeout, err = serverTimeout(ctx, &QueryOptions{TimeoutDuration: time.Minute})
+	if err != nil || timeout <= 0 || timeout > 1000 {
+		t.Errorf("Expected timeout capped by context deadline, got %d (%v)", timeout, err)
+	}
+
+	expired, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+
+	if _, err := serverTimeout(expired, &QueryOptions{}); err == nil {
+		t.Error("Expected error for expired context")
+	}
+}
@@ -0,0 +1,113 @@
+package falkordb
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+)
+
+// ExecutionPlan is the operation tree returned by Graph.Explain and Graph.Profile.
+type ExecutionPlan struct {
+	// Root is the final operation of the plan, usually Results.
+	Root *Operation
+
+	// Profiled reports whether the plan was executed and carries
+	// RecordsProduced and ExecutionTime for each operation.
+	Profiled bool
+}
+
+// Operation is a single step of an execution plan.
+type Operation struct {
+	Name string
+
+	// Args describes what the operation works on, such as the pattern
+	// "(n:Person)" of a scan or the expression of a filter.
+	Args string
+
+	// Children are the operations that feed records into this one.
+	Children []*Operation
+
+	// RecordsProduced and ExecutionTime are only set on profiled plans.
+	RecordsProduced int64
+	ExecutionTime   time.Duration
+}
+
+// Operations returns every operation of the plan in depth-first order, starting at the root.
+func (p *ExecutionPlan) Operations() []*Operation {
+	var ops []*Operation
+	var walk func(op *Operation)
+	walk = func(op *Operation) {
+		ops = append(ops, op)
+		for _, child := range op.Children {
+			walk(child)
+		}
+	}
+	if p.Root != nil {
+		walk(p.Root)
+	}
+	return ops
+}
+
+// String returns the plan in the indented text form used by FalkorDB.
+func (p *ExecutionPlan) String() string {
+	var b strings.Builder
+	var write func(op *Operation, depth int)
+	write = func(op *Operation, depth int) {
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(strings.Repeat("    ", depth))
+		b.WriteString(op.Name)
+		if op.Args != "" {
+			b.WriteString(" | " + op.Args)
+		}
+		if p.Profiled {
+			fmt.Fprintf(&b, " | Records produced: %d, Execution time: %f ms",
+				op.RecordsProduced, float64(op.ExecutionTime)/float64(time.Millisecond))
+		}
+		for _, child := range op.Children {
+			write(child, depth+1)
+		}
+	}
+	if p.Root != nil {
+		write(p.Root, 0)
+	}
+	return b.String()
+}
+
+// parsePlan builds an ExecutionPlan from the lines of an EXPLAIN or PROFILE reply.
+func parsePlan(lines []string) (*ExecutionPlan, error) {
+	steps, err := proto.ParsePlan(lines)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &ExecutionPlan{}
+	var stack []*Operation
+	for i, step := range steps {
+		if step.Depth > len(stack) || (step.Depth == 0 && plan.Root != nil) {
+			return nil, fmt.Errorf("unexpected plan structure at %q", lines[i])
+		}
+
+		op := &Operation{
+			Name:            step.Name,
+			Args:            step.Args,
+			RecordsProduced: step.RecordsProduced,
+			ExecutionTime:   time.Duration(math.Round(step.ExecutionTimeMs * float64(time.Millisecond))),
+		}
+		plan.Profiled = plan.Profiled || step.Profiled
+
+		stack = stack[:step.Depth]
+		if step.Depth == 0 {
+			plan.Root = op
+		} else {
+			parent := stack[step.Depth-1]
+			parent.Children = append(parent.Children, op)
+		}
+		stack = append(stack, op)
+	}
+	return plan, nil
+}
@@ -0,0 +1,85 @@
+package falkordb
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParsePlan(t *testing.T) {
+	lines := []string{
+		"Results | Records produced: 1, Execution time: 0.001200 ms",
+		"    Project | Records produced: 1, Execution time: 0.002000 ms",
+		"        Cartesian Product | Records produced: 1, Execution time: 0.010000 ms",
+		"            Node By Label Scan | (a:Person) | Records produced: 1, Execution time: 0.004500 ms",
+		"            All Node Scan | (b) | Records produced: 1, Execution time: 0.003000 ms",
+	}
+
+	plan, err := parsePlan(lines)
+	if err != nil {
+		t.Fatalf("parsePlan failed: %v", err)
+	}
+
+	if !plan.Profiled {
+		t.Error("Expected a profiled plan")
+	}
+	product := plan.Root.Children[0].Children[0]
+	if product.Name != "Cartesian Product" || len(product.Children) != 2 {
+		t.Fatalf("Unexpected tree: %+v", product)
+	}
+	scan := product.Children[0]
+	if scan.Args != "(a:Person)" || scan.RecordsProduced != 1 || scan.ExecutionTime != 4500*time.Nanosecond {
+		t.Errorf("Unexpected scan: %+v", scan)
+	}
+
+	var names []string
+	for _, op := range plan.Operations() {
+		names = append(names, op.Name)
+	}
+	if got := strings.Join(names, ","); got != "Results,Project,Cartesian Product,Node By Label Scan,All Node Scan" {
+		t.Errorf("Operations() = %s", got)
+	}
+
+	if plan.String() != strings.Join(lines, "\n") {
+		t.Errorf("String() did not reproduce the plan:\n%s", plan)
+	}
+}
+
+func TestParsePlanInvalid(t *testing.T) {
+	for _, lines := range [][]string{
+		{"Results", "        Project"},
+		{"Results", "Results"},
+	} {
+		if _, err := parsePlan(lines); err == nil {
+			t.Errorf("Expected error for %q", lines)
+		}
+	}
+}
+
+func TestExplainParams(t *testing.T) {
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		return []interface{}{"Results", "    Node By Label Scan | (n:Person)"}, nil
+	}}
+	graph := newTestGraph(client, WithTimeout(time.Second))
+
+	plan, err := graph.Explain(context.Background(), "MATCH (n:Person {name: $name}) RETURN n", WithParam("name", "Alice"))
+	if err != nil {
+		t.Fatalf("Explain failed: %v", err)
+	}
+	if plan.Root.Children[0].Args != "(n:Person)" {
+		t.Errorf("Unexpected plan:\n%s", plan)
+	}
+
+	cmd := client.commands[0]
+	if cmd[0] != "GRAPH.EXPLAIN" || cmd[2] != `CYPHER name="Alice" MATCH (n:Person {name: $name}) RETURN n` || len(cmd) != 3 {
+		t.Errorf("Unexpected command: %v", cmd)
+	}
+
+	if _, err := graph.Profile(context.Background(), "MATCH (n) RETURN n"); err != nil {
+		t.Fatalf("Profile failed: %v", err)
+	}
+	if cmd := client.commands[1]; cmd[0] != "GRAPH.PROFILE" || cmd[3] != "TIMEOUT" {
+		t.Errorf("Unexpected command: %v", cmd)
+	}
+}
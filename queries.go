@@ -0,0 +1,212 @@
+package falkordb
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+	"github.com/flancast90/falkordb-go/internal/redis"
+)
+
+// Field names of GRAPH.INFO query entries.
+const (
+	infoReceivedAt        = "Received at"
+	infoGraphName         = "Graph name"
+	infoQuery             = "Query"
+	infoWaitDuration      = "Wait duration"
+	infoExecutionDuration = "Execution duration"
+	infoReplicated        = "Replicated command"
+)
+
+// QueryInfo describes a query that is executing or waiting to execute.
+type QueryInfo struct {
+	Graph      string
+	Query      string
+	ReceivedAt time.Time
+
+	// WaitDuration is how long the query waited for a worker thread.
+	WaitDuration time.Duration
+
+	// ExecutionDuration is how long the query has been executing.
+	// It is zero for waiting queries.
+	ExecutionDuration time.Duration
+
+	// Replicated reports whether the query was replicated from a primary.
+	Replicated bool
+
+	// Waiting reports whether the query is still waiting to execute.
+	Waiting bool
+
+	// Node is the address of the server running the query.
+	Node string
+}
+
+// RunningQueries returns the queries currently executing on the server.
+// In cluster mode every node, primaries and replicas, is asked.
+func (db *FalkorDB) RunningQueries(ctx context.Context) ([]QueryInfo, error) {
+	return db.queryInfo(ctx, "RunningQueries", false)
+}
+
+// WaitingQueries returns the queries waiting for a worker thread.
+// In cluster mode every node, primaries and replicas, is asked.
+func (db *FalkorDB) WaitingQueries(ctx context.Context) ([]QueryInfo, error) {
+	return db.queryInfo(ctx, "WaitingQueries", true)
+}
+
+// queryInfo collects a GRAPH.INFO section from every node, in node order.
+func (db *FalkorDB) queryInfo(ctx context.Context, section string, waiting bool) ([]QueryInfo, error) {
+	nodes, err := db.client.Nodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	perNode := make([][]QueryInfo, len(nodes))
+	errs := make([]error, len(nodes))
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		wg.Add(1)
+		go func(i int, node redis.Node) {
+			defer wg.Done()
+			perNode[i], errs[i] = nodeQueryInfo(ctx, node, section, waiting)
+			if errs[i] != nil && len(nodes) > 1 {
+				errs[i] = fmt.Errorf("falkordb: GRAPH.INFO on %s: %w", node.Addr, errs[i])
+			}
+		}(i, node)
+	}
+	wg.Wait()
+
+	queries := []QueryInfo{}
+	for i := range nodes {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		queries = append(queries, perNode[i]...)
+	}
+	return queries, nil
+}
+
+func nodeQueryInfo(ctx context.Context, node redis.Node, section string, waiting bool) ([]QueryInfo, error) {
+	result, err := node.Do(ctx, "GRAPH.INFO", section).Result()
+	if err != nil {
+		return nil, wrapError(err, "", "")
+	}
+
+	raw, err := proto.ParseQueryInfo(result)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := make([]QueryInfo, len(raw))
+	for i, r := range raw {
+		queries[i] = QueryInfo{
+			Graph:             proto.ToString(r[infoGraphName]),
+			Query:             proto.ToString(r[infoQuery]),
+			ReceivedAt:        unixTime(proto.ToInt64(r[infoReceivedAt])),
+			WaitDuration:      millis(proto.ToFloat64(r[infoWaitDuration])),
+			ExecutionDuration: millis(proto.ToFloat64(r[infoExecutionDuration])),
+			Replicated:        proto.ToInt64(r[infoReplicated]) != 0,
+			Waiting:           waiting,
+			Node:              node.Addr,
+		}
+	}
+	return queries, nil
+}
+
+// unixTime converts a Unix timestamp in seconds or milliseconds to a time.
+// Timestamps in seconds stay below 1e11 until the year 5138.
+func unixTime(ts int64) time.Time {
+	if ts == 0 {
+		return time.Time{}
+	}
+	if ts >= 1e11 {
+		return time.UnixMilli(ts)
+	}
+	return time.Unix(ts, 0)
+}
+
+// millis converts fractional milliseconds to a duration.
+func millis(ms float64) time.Duration {
+	return time.Duration(math.Round(ms * float64(time.Millisecond)))
+}
+
+// WatchStuckQueries polls the running and waiting queries every interval
+// and sends each query whose wait and execution time together exceed
+// threshold. A query is sent once, when it is first seen over the threshold.
+// In cluster mode every node is polled.
+//
+// Polling errors are sent on the error channel, dropping any that arrive
+// while an earlier one is unread, and polling continues. Both channels are
+// closed once ctx is done. If interval is not positive, the error channel
+// carries an error and both channels are closed without polling.
+func (db *FalkorDB) WatchStuckQueries(ctx context.Context, interval, threshold time.Duration) (<-chan QueryInfo, <-chan error) {
+	if interval <= 0 {
+		return invalidInterval[QueryInfo](interval)
+	}
+
+	stuck := make(chan QueryInfo)
+	errs := make(chan error, 1)
+
+	type queryKey struct {
+		node, graph, query string
+		received           time.Time
+	}
+
+	go func() {
+		defer close(stuck)
+		defer close(errs)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		reported := make(map[queryKey]bool)
+		for {
+			running, err := db.RunningQueries(ctx)
+			var waiting []QueryInfo
+			if err == nil {
+				waiting, err = db.WaitingQueries(ctx)
+			}
+
+			if err != nil {
+				if ctx.Err() == nil {
+					select {
+					case errs <- err:
+					default:
+					}
+				}
+			} else {
+				current := make(map[queryKey]bool)
+				for _, q := range append(running, waiting...) {
+					key := queryKey{q.Node, q.Graph, q.Query, q.ReceivedAt}
+					current[key] = true
+					if reported[key] || q.WaitDuration+q.ExecutionDuration < threshold {
+						continue
+					}
+					select {
+					case stuck <- q:
+						reported[key] = true
+					case <-ctx.Done():
+						return
+					}
+				}
+
+				// Forget queries that have finished
+				for key := range reported {
+					if !current[key] {
+						delete(reported, key)
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return stuck, errs
+}
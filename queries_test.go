@@ -0,0 +1,164 @@
+package falkordb
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flancast90/falkordb-go/internal/redis"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+func queryInfoReply(title string, queries ...[]interface{}) interface{} {
+	items := make([]interface{}, len(queries))
+	for i, q := range queries {
+		items[i] = q
+	}
+	return []interface{}{title, items}
+}
+
+func TestRunningQueries(t *testing.T) {
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		if args[1] == "RunningQueries" {
+			return queryInfoReply("# Running queries", []interface{}{
+				"Received at", int64(1700000000123),
+				"Graph name", "social",
+				"Query", "MATCH (n) RETURN n",
+				"Wait duration", "1.5",
+				"Execution duration", "250.25",
+				"Replicated command", int64(1),
+			}), nil
+		}
+		return queryInfoReply("# Waiting queries", []interface{}{
+			"Received at", int64(1700000000),
+			"Graph name", "social",
+			"Query", "RETURN 1",
+			"Wait duration", int64(30),
+			"Replicated command", int64(0),
+		}), nil
+	}}
+	db := &FalkorDB{client: client, opts: &Options{}}
+	ctx := context.Background()
+
+	running, err := db.RunningQueries(ctx)
+	if err != nil {
+		t.Fatalf("RunningQueries failed: %v", err)
+	}
+	expected := QueryInfo{
+		Graph:             "social",
+		Query:             "MATCH (n) RETURN n",
+		ReceivedAt:        time.UnixMilli(1700000000123),
+		WaitDuration:      1500 * time.Microsecond,
+		ExecutionDuration: 250250 * time.Microsecond,
+		Replicated:        true,
+		Node:              "localhost:6379",
+	}
+	if len(running) != 1 || running[0] != expected {
+		t.Errorf("RunningQueries = %+v, expected %+v", running, expected)
+	}
+
+	waiting, err := db.WaitingQueries(ctx)
+	if err != nil {
+		t.Fatalf("WaitingQueries failed: %v", err)
+	}
+	expected = QueryInfo{
+		Graph:        "social",
+		Query:        "RETURN 1",
+		ReceivedAt:   time.Unix(1700000000, 0),
+		WaitDuration: 30 * time.Millisecond,
+		Waiting:      true,
+		Node:         "localhost:6379",
+	}
+	if len(waiting) != 1 || waiting[0] != expected {
+		t.Errorf("WaitingQueries = %+v, expected %+v", waiting, expected)
+	}
+}
+
+func TestRunningQueriesAcrossCluster(t *testing.T) {
+	node := func(addr, query string) redis.Node {
+		return redis.Node{Addr: addr, Primary: true, Do: func(ctx context.Context, args ...interface{}) *goredis.Cmd {
+			cmd := goredis.NewCmd(ctx, args...)
+			cmd.SetVal(queryInfoReply("# Running queries", []interface{}{"Graph name", "g", "Query", query}))
+			return cmd
+		}}
+	}
+	client := &fakeClient{nodes: []redis.Node{node("10.0.0.1:6379", "first"), node("10.0.0.2:6379", "second")}}
+	db := &FalkorDB{client: client, opts: &Options{}}
+
+	running, err := db.RunningQueries(context.Background())
+	if err != nil {
+		t.Fatalf("RunningQueries failed: %v", err)
+	}
+	if len(running) != 2 ||
+		running[0].Query != "first" || running[0].Node != "10.0.0.1:6379" ||
+		running[1].Query != "second" || running[1].Node != "10.0.0.2:6379" {
+		t.Errorf("Expected one query per node, got %+v", running)
+	}
+}
+
+func TestWatchStuckQueries(t *testing.T) {
+	query := func(q string, received, executing int64) []interface{} {
+		return []interface{}{"Received at", received, "Graph name", "g", "Query", q, "Execution duration", executing}
+	}
+
+	var mu sync.Mutex
+	polls := [][][]interface{}{
+		{query("slow", 1, 50), query("fast", 2, 1)},
+		{query("slow", 1, 150), query("fast", 2, 2)},
+		{query("slow", 1, 250)},
+		{query("slow", 3, 500)}, // same text, new execution
+	}
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		if args[1] == "WaitingQueries" {
+			return queryInfoReply("# Waiting queries"), nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		reply := polls[0]
+		if len(polls) > 1 {
+			polls = polls[1:]
+		}
+		return queryInfoReply("# Running queries", reply...), nil
+	}}
+	db := &FalkorDB{client: client, opts: &Options{}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stuck, _ := db.WatchStuckQueries(ctx, time.Millisecond, 100*time.Millisecond)
+
+	for _, expected := range []QueryInfo{
+		{Graph: "g", Query: "slow", ReceivedAt: time.Unix(1, 0), ExecutionDuration: 150 * time.Millisecond, Node: "localhost:6379"},
+		{Graph: "g", Query: "slow", ReceivedAt: time.Unix(3, 0), ExecutionDuration: 500 * time.Millisecond, Node: "localhost:6379"},
+	} {
+		select {
+		case q := <-stuck:
+			if q != expected {
+				t.Errorf("Got %+v, expected %+v", q, expected)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for stuck query")
+		}
+	}
+
+	cancel()
+	for q := range stuck {
+		t.Errorf("Unexpected stuck query: %+v", q)
+	}
+}
+
+func TestWatchStuckQueriesInvalidInterval(t *testing.T) {
+	client := &fakeClient{}
+	db := &FalkorDB{client: client, opts: &Options{}}
+
+	stuck, errs := db.WatchStuckQueries(context.Background(), 0, time.Second)
+	if err := <-errs; err == nil {
+		t.Error("Expected an error for a zero interval")
+	}
+	if _, ok := <-stuck; ok {
+		t.Error("Expected the query channel to be closed")
+	}
+	if len(client.commands) != 0 {
+		t.Errorf("Expected no polling, got %v", client.commands)
+	}
+}
@@ -2,6 +2,7 @@ package falkordb
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/flancast90/falkordb-go/internal/proto"
 )
@@ -12,11 +13,15 @@ type QueryResult struct {
 	Headers []Header
 
 	// Data contains the result rows as maps of column name to value.
-	// Values can be: string, int64, float64, bool, nil, *Node, *Edge, *Path, *Point, map, slice
+	// Values can be: string, int64, float64, bool, nil, *Node, *Edge, *Path, *Point,
+	// *DateTime, *Date, *Time, *Duration, []float32 (vectors), map, slice
 	Data []map[string]interface{}
 
-	// Metadata contains query execution statistics.
+	// Metadata contains the raw query execution statistics.
 	Metadata []string
+
+	// Stats contains the parsed query execution statistics.
+	Stats Stats
 }
 
 // Header represents a column header in the query result.
@@ -40,6 +45,7 @@ func newResultParser() *resultParser {
 func (p *resultParser) parseResult(raw *proto.RawResult) (*QueryResult, error) {
 	result := &QueryResult{
 		Metadata: raw.Metadata,
+		Stats:    parseStats(raw.Metadata),
 	}
 
 	// Parse headers
@@ -117,6 +123,16 @@ func (p *resultParser) parseValue(valueType proto.ValueType, value interface{})
 		return p.parseMap(value)
 	case proto.ValueTypePoint:
 		return p.parsePoint(value)
+	case proto.ValueTypeVectorF32:
+		return parseVectorF32(value)
+	case proto.ValueTypeDateTime:
+		return &DateTime{Time: proto.ToTime(value)}
+	case proto.ValueTypeDate:
+		return parseDate(value)
+	case proto.ValueTypeTime:
+		return parseTime(value)
+	case proto.ValueTypeDuration:
+		return parseDuration(value)
 	default:
 		return value
 	}
@@ -300,6 +316,56 @@ func (p *resultParser) parsePoint(value interface{}) *Point {
 	}
 }
 
+// parseVectorF32 converts a vector of floats to a []float32.
+func parseVectorF32(value interface{}) []float32 {
+	arr, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	vec := make([]float32, len(arr))
+	for i, item := range arr {
+		vec[i] = proto.ToFloat32(item)
+	}
+	return vec
+}
+
+// parseDate converts a date, sent as the Unix time of its midnight UTC, to a Date.
+func parseDate(value interface{}) *Date {
+	t := proto.ToTime(value)
+	return &Date{Year: t.Year(), Month: int(t.Month()), Day: t.Day()}
+}
+
+// parseTime converts a time, sent as a Unix time on the epoch day, to a Time.
+func parseTime(value interface{}) *Time {
+	t := proto.ToTime(value)
+	return &Time{Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(), Nanosecond: t.Nanosecond()}
+}
+
+// parseDuration converts a duration, sent as a number of seconds, to a Duration.
+// The server does not preserve calendar units, so the result is normalized to
+// days, hours, minutes and seconds. Every component of a negative duration is
+// negative, so it encodes back to the same value.
+func parseDuration(value interface{}) *Duration {
+	d := proto.ToDuration(value)
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+	seconds := d / time.Second
+	d -= seconds * time.Second
+
+	return &Duration{
+		Days:        int(days),
+		Hours:       int(hours),
+		Minutes:     int(minutes),
+		Seconds:     int(seconds),
+		Nanoseconds: int(d),
+	}
+}
+
 func (p *resultParser) parseProperties(props []interface{}) map[string]interface{} {
 	result := make(map[string]interface{})
 
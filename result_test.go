@@ -0,0 +1,107 @@
+package falkordb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+)
+
+func TestParseTemporalValues(t *testing.T) {
+	p := newResultParser()
+	p.updateMetadata([]string{"Event"}, nil, []string{"at"})
+
+	const march1 = int64(1709251200) // 2024-03-01T00:00:00Z
+	dt := []interface{}{int64(proto.ValueTypeDateTime), march1 + 3661}
+
+	raw := &proto.RawResult{
+		Headers: []interface{}{
+			[]interface{}{1, "dt"}, []interface{}{1, "d"}, []interface{}{1, "t"},
+			[]interface{}{1, "dur"}, []interface{}{1, "arr"}, []interface{}{1, "m"}, []interface{}{1, "n"},
+			[]interface{}{1, "neg"}, []interface{}{1, "negfrac"},
+		},
+		Data: []interface{}{
+			[]interface{}{
+				dt,
+				[]interface{}{int64(proto.ValueTypeDate), march1},
+				[]interface{}{int64(proto.ValueTypeTime), int64(13*3600 + 5*60 + 9)},
+				[]interface{}{int64(proto.ValueTypeDuration), int64(2*86400 + 3*3600 + 4)},
+				[]interface{}{int64(proto.ValueTypeArray), []interface{}{dt}},
+				[]interface{}{int64(proto.ValueTypeMap), []interface{}{"at", dt}},
+				[]interface{}{int64(proto.ValueTypeNode), []interface{}{
+					int64(1), []interface{}{int64(0)},
+					[]interface{}{[]interface{}{int64(0), int64(proto.ValueTypeDateTime), march1 + 3661}},
+				}},
+				[]interface{}{int64(proto.ValueTypeDuration), int64(-(86400 + 2*3600 + 5))},
+				[]interface{}{int64(proto.ValueTypeDuration), "-1.5"},
+			},
+		},
+	}
+
+	result, err := p.parseResult(raw)
+	if err != nil {
+		t.Fatalf("parseResult failed: %v", err)
+	}
+	row := result.Data[0]
+	want := time.Date(2024, 3, 1, 1, 1, 1, 0, time.UTC)
+
+	if v, ok := row["dt"].(*DateTime); !ok || !v.Equal(want) {
+		t.Errorf("dt = %v, expected %v", row["dt"], want)
+	}
+	if v, ok := row["d"].(*Date); !ok || *v != (Date{Year: 2024, Month: 3, Day: 1}) {
+		t.Errorf("d = %v", row["d"])
+	}
+	if v, ok := row["t"].(*Time); !ok || *v != (Time{Hour: 13, Minute: 5, Second: 9}) {
+		t.Errorf("t = %v", row["t"])
+	}
+	if v, ok := row["dur"].(*Duration); !ok || *v != (Duration{Days: 2, Hours: 3, Seconds: 4}) {
+		t.Errorf("dur = %v", row["dur"])
+	}
+	// Negative durations decode with negative components and encode back
+	// to the same value
+	if v, ok := row["neg"].(*Duration); !ok || *v != (Duration{Days: -1, Hours: -2, Seconds: -5}) {
+		t.Errorf("neg = %v", row["neg"])
+	} else if s, _ := v.MarshalCypher(); s != `duration("P-1DT-2H-5S")` {
+		t.Errorf("neg encodes as %s", s)
+	}
+	if v, ok := row["negfrac"].(*Duration); !ok || *v != (Duration{Seconds: -1, Nanoseconds: -500000000}) {
+		t.Errorf("negfrac = %v", row["negfrac"])
+	} else if v.String() != "PT-1.5S" || v.ToDuration() != -1500*time.Millisecond {
+		t.Errorf("negfrac = %s (%v)", v, v.ToDuration())
+	}
+	if arr, ok := row["arr"].([]interface{}); !ok || len(arr) != 1 {
+		t.Errorf("arr = %v", row["arr"])
+	} else if v, ok := arr[0].(*DateTime); !ok || !v.Equal(want) {
+		t.Errorf("arr[0] = %v, expected %v", arr[0], want)
+	}
+	if m, ok := row["m"].(map[string]interface{}); !ok {
+		t.Errorf("m = %v", row["m"])
+	} else if v, ok := m["at"].(*DateTime); !ok || !v.Equal(want) {
+		t.Errorf("m[at] = %v, expected %v", m["at"], want)
+	}
+	if n, ok := row["n"].(*Node); !ok {
+		t.Errorf("n = %v", row["n"])
+	} else if v, ok := n.Properties["at"].(*DateTime); !ok || !v.Equal(want) {
+		t.Errorf("n.at = %v, expected %v", n.Properties["at"], want)
+	}
+}
+
+func TestParseVectorF32(t *testing.T) {
+	p := newResultParser()
+
+	value := p.parseValue(proto.ValueTypeVectorF32, []interface{}{"0.1", float64(-2), "3.5e-08"})
+	vec, ok := value.([]float32)
+	if !ok {
+		t.Fatalf("Expected []float32, got %T", value)
+	}
+
+	expected := []float32{0.1, -2, 3.5e-8}
+	if len(vec) != len(expected) {
+		t.Fatalf("Expected %d elements, got %d", len(expected), len(vec))
+	}
+	for i := range expected {
+		if vec[i] != expected[i] {
+			t.Errorf("vec[%d] = %v, expected %v", i, vec[i], expected[i])
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package falkordb
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+)
+
+// SlowLogEntry represents an entry in the slow query log.
+type SlowLogEntry struct {
+	// Timestamp is when the query was run, with second precision.
+	Timestamp time.Time
+	Command   string
+	Query     string
+	Took      time.Duration
+}
+
+// SlowLog returns the slow query log for this graph.
+func (g *Graph) SlowLog(ctx context.Context) ([]SlowLogEntry, error) {
+	result, err := g.client.Do(ctx, "GRAPH.SLOWLOG", g.name).Result()
+	if err != nil {
+		return nil, wrapError(err, g.name, "")
+	}
+
+	raw, err := proto.ParseSlowLogResult(result)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]SlowLogEntry, len(raw))
+	for i, r := range raw {
+		entries[i] = SlowLogEntry{
+			Timestamp: time.Unix(proto.ToInt64(r["timestamp"]), 0),
+			Command:   proto.ToString(r["command"]),
+			Query:     proto.ToString(r["query"]),
+			Took:      time.Duration(math.Round(proto.ToFloat64(r["took"]) * float64(time.Millisecond))),
+		}
+	}
+	return entries, nil
+}
+
+// SlowLogReset clears the slow query log for this graph.
+func (g *Graph) SlowLogReset(ctx context.Context) error {
+	return wrapError(g.client.Do(ctx, "GRAPH.SLOWLOG", g.name, "RESET").Err(), g.name, "")
+}
+
+// WatchSlowLog polls the slow query log every interval and sends entries
+// that were not in the log at the previous poll, oldest first. Entries
+// already in the log when watching starts are not sent.
+//
+// Polling errors are sent on the error channel, dropping any that arrive
+// while an earlier one is unread, and polling continues. Both channels are
+// closed once ctx is done. If interval is not positive, the error channel
+// carries an error and both channels are closed without polling.
+//
+// The server keeps a small number of the slowest recent queries, so entries
+// that enter and leave the log between two polls are missed; use a shorter
+// interval for busy graphs.
+func (g *Graph) WatchSlowLog(ctx context.Context, interval time.Duration) (<-chan SlowLogEntry, <-chan error) {
+	if interval <= 0 {
+		return invalidInterval[SlowLogEntry](interval)
+	}
+
+	entries := make(chan SlowLogEntry)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(entries)
+		defer close(errs)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var seen map[SlowLogEntry]bool
+		for {
+			current, err := g.SlowLog(ctx)
+			if err != nil && ctx.Err() == nil {
+				select {
+				case errs <- err:
+				default:
+				}
+			}
+
+			if err == nil {
+				fresh := make([]SlowLogEntry, 0, len(current))
+				for _, entry := range current {
+					if seen != nil && !seen[entry] {
+						fresh = append(fresh, entry)
+					}
+				}
+				sort.SliceStable(fresh, func(i, j int) bool {
+					return fresh[i].Timestamp.Before(fresh[j].Timestamp)
+				})
+				for _, entry := range fresh {
+					select {
+					case entries <- entry:
+					case <-ctx.Done():
+						return
+					}
+				}
+
+				seen = make(map[SlowLogEntry]bool, len(current))
+				for _, entry := range current {
+					seen[entry] = true
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return entries, errs
+}
+
+// invalidInterval returns the closed channels of a watcher that cannot
+// start because interval is not positive, with the error buffered.
+func invalidInterval[T any](interval time.Duration) (<-chan T, <-chan error) {
+	values := make(chan T)
+	errs := make(chan error, 1)
+	errs <- fmt.Errorf("falkordb: watch interval must be positive, got %v", interval)
+	close(values)
+	close(errs)
+	return values, errs
+}
@@ -0,0 +1,131 @@
+package falkordb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func slowLogReply(entries ...[]interface{}) interface{} {
+	reply := make([]interface{}, len(entries))
+	for i, e := range entries {
+		reply[i] = e
+	}
+	return reply
+}
+
+func TestSlowLog(t *testing.T) {
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		if len(args) == 3 {
+			return "OK", nil
+		}
+		return slowLogReply([]interface{}{"1700000000", "GRAPH.QUERY", "MATCH (n) RETURN n", "12.5"}), nil
+	}}
+	graph := newTestGraph(client)
+	ctx := context.Background()
+
+	entries, err := graph.SlowLog(ctx)
+	if err != nil {
+		t.Fatalf("SlowLog failed: %v", err)
+	}
+	expected := SlowLogEntry{
+		Timestamp: time.Unix(1700000000, 0),
+		Command:   "GRAPH.QUERY",
+		Query:     "MATCH (n) RETURN n",
+		Took:      12500 * time.Microsecond,
+	}
+	if len(entries) != 1 || entries[0] != expected {
+		t.Errorf("SlowLog = %+v, expected %+v", entries, expected)
+	}
+
+	if err := graph.SlowLogReset(ctx); err != nil {
+		t.Fatalf("SlowLogReset failed: %v", err)
+	}
+	last := client.commands[len(client.commands)-1]
+	if last[0] != "GRAPH.SLOWLOG" || last[1] != "test" || last[2] != "RESET" {
+		t.Errorf("Unexpected command: %v", last)
+	}
+}
+
+func TestWatchSlowLog(t *testing.T) {
+	old := []interface{}{"100", "GRAPH.QUERY", "old", "10"}
+	second := []interface{}{"102", "GRAPH.QUERY", "second", "20"}
+	first := []interface{}{"101", "GRAPH.RO_QUERY", "first", "30"}
+	third := []interface{}{"103", "GRAPH.QUERY", "third", "40"}
+
+	var mu sync.Mutex
+	polls := []interface{}{
+		slowLogReply(old),
+		errors.New("connection reset"),
+		slowLogReply(old, second, first),
+		slowLogReply(second, first, third),
+	}
+	client := &fakeClient{handler: func(args []interface{}) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		reply := polls[0]
+		if len(polls) > 1 {
+			polls = polls[1:]
+		}
+		if err, ok := reply.(error); ok {
+			return nil, err
+		}
+		return reply, nil
+	}}
+	graph := newTestGraph(client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	entries, errs := graph.WatchSlowLog(ctx, time.Millisecond)
+
+	var queries []string
+	for len(queries) < 3 {
+		select {
+		case entry := <-entries:
+			queries = append(queries, entry.Query)
+		case <-time.After(time.Second):
+			t.Fatalf("Timed out with entries %v", queries)
+		}
+	}
+	if queries[0] != "first" || queries[1] != "second" || queries[2] != "third" {
+		t.Errorf("Unexpected entries: %v", queries)
+	}
+
+	select {
+	case err := <-errs:
+		if err == nil || err.Error() != "connection reset" {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	default:
+		t.Error("Expected the polling error to be reported")
+	}
+
+	cancel()
+	for range entries {
+	}
+	if _, ok := <-errs; ok {
+		t.Error("Expected error channel to be closed")
+	}
+}
+
+func TestWatchSlowLogInvalidInterval(t *testing.T) {
+	client := &fakeClient{}
+	graph := newTestGraph(client)
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		entries, errs := graph.WatchSlowLog(context.Background(), interval)
+		if err := <-errs; err == nil {
+			t.Errorf("Expected an error for interval %v", interval)
+		}
+		if _, ok := <-entries; ok {
+			t.Errorf("Expected the entries channel to be closed for interval %v", interval)
+		}
+		if _, ok := <-errs; ok {
+			t.Errorf("Expected the error channel to be closed for interval %v", interval)
+		}
+	}
+	if len(client.commands) != 0 {
+		t.Errorf("Expected no polling, got %v", client.commands)
+	}
+}
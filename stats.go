@@ -0,0 +1,117 @@
+package falkordb
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+)
+
+// Statistic names reported by FalkorDB in the query metadata.
+const (
+	statLabelsAdded          = "Labels added"
+	statLabelsRemoved        = "Labels removed"
+	statNodesCreated         = "Nodes created"
+	statNodesDeleted         = "Nodes deleted"
+	statPropertiesSet        = "Properties set"
+	statPropertiesRemoved    = "Properties removed"
+	statRelationshipsCreated = "Relationships created"
+	statRelationshipsDeleted = "Relationships deleted"
+	statIndicesCreated       = "Indices created"
+	statIndicesDeleted       = "Indices deleted"
+	statCachedExecution      = "Cached execution"
+	statExecutionTime        = "Query internal execution time"
+)
+
+// Stats contains the execution statistics reported for a query.
+type Stats struct {
+	NodesCreated         int
+	NodesDeleted         int
+	RelationshipsCreated int
+	RelationshipsDeleted int
+	PropertiesSet        int
+	PropertiesRemoved    int
+	LabelsAdded          int
+	LabelsRemoved        int
+	IndicesCreated       int
+	IndicesDeleted       int
+
+	// CachedExecution reports whether the query plan was served from the server's cache.
+	CachedExecution bool
+
+	// ExecutionTime is the server-side execution time of the query.
+	ExecutionTime time.Duration
+
+	// Other holds statistics not recognized by this client, keyed by name.
+	Other map[string]string
+}
+
+// ContainsUpdates reports whether the query modified the graph.
+func (s *Stats) ContainsUpdates() bool {
+	return s.NodesCreated > 0 || s.NodesDeleted > 0 ||
+		s.RelationshipsCreated > 0 || s.RelationshipsDeleted > 0 ||
+		s.PropertiesSet > 0 || s.PropertiesRemoved > 0 ||
+		s.LabelsAdded > 0 || s.LabelsRemoved > 0 ||
+		s.IndicesCreated > 0 || s.IndicesDeleted > 0
+}
+
+// parseStats converts raw metadata lines into Stats.
+// Unknown or malformed statistics are kept in Stats.Other.
+func parseStats(metadata []string) Stats {
+	var stats Stats
+
+	for key, value := range proto.ParseStatistics(metadata) {
+		var counter *int
+		switch key {
+		case statLabelsAdded:
+			counter = &stats.LabelsAdded
+		case statLabelsRemoved:
+			counter = &stats.LabelsRemoved
+		case statNodesCreated:
+			counter = &stats.NodesCreated
+		case statNodesDeleted:
+			counter = &stats.NodesDeleted
+		case statPropertiesSet:
+			counter = &stats.PropertiesSet
+		case statPropertiesRemoved:
+			counter = &stats.PropertiesRemoved
+		case statRelationshipsCreated:
+			counter = &stats.RelationshipsCreated
+		case statRelationshipsDeleted:
+			counter = &stats.RelationshipsDeleted
+		case statIndicesCreated:
+			counter = &stats.IndicesCreated
+		case statIndicesDeleted:
+			counter = &stats.IndicesDeleted
+		case statCachedExecution:
+			stats.CachedExecution = value == "1"
+			continue
+		case statExecutionTime:
+			if d, err := proto.ParseExecutionTime(value); err == nil {
+				stats.ExecutionTime = d
+				continue
+			}
+			stats.setOther(key, value)
+			continue
+		default:
+			stats.setOther(key, value)
+			continue
+		}
+
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			stats.setOther(key, value)
+			continue
+		}
+		*counter = n
+	}
+
+	return stats
+}
+
+func (s *Stats) setOther(key, value string) {
+	if s.Other == nil {
+		s.Other = make(map[string]string)
+	}
+	s.Other[key] = value
+}
@@ -0,0 +1,44 @@
+package falkordb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseStats(t *testing.T) {
+	stats := parseStats([]string{
+		"Labels added: 1",
+		"Nodes created: 2",
+		"Properties set: 3",
+		"Relationships created: x",
+		"Nodes deleted: 1.5",
+		"Cached execution: 1",
+		"Query internal execution time: 0.5 milliseconds",
+		"Some new counter: 7",
+	})
+
+	expected := Stats{
+		LabelsAdded:     1,
+		NodesCreated:    2,
+		PropertiesSet:   3,
+		CachedExecution: true,
+		ExecutionTime:   500 * time.Microsecond,
+		Other: map[string]string{
+			"Relationships created": "x",
+			"Nodes deleted":         "1.5",
+			"Some new counter":      "7",
+		},
+	}
+	if !reflect.DeepEqual(stats, expected) {
+		t.Errorf("parseStats = %+v, expected %+v", stats, expected)
+	}
+	if !stats.ContainsUpdates() {
+		t.Error("Expected ContainsUpdates to be true")
+	}
+
+	stats = parseStats([]string{"Query internal execution time: soon"})
+	if stats.ExecutionTime != 0 || stats.Other["Query internal execution time"] != "soon" {
+		t.Errorf("Malformed execution time not kept in Other: %+v", stats)
+	}
+}
@@ -0,0 +1,124 @@
+package falkordb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+)
+
+// Stmt is a prepared Cypher query that can be executed repeatedly with
+// different parameters. It is safe for concurrent use by multiple goroutines.
+//
+// The query text sent to the server is identical on every execution, so the
+// server's plan cache is reused across parameter sets.
+type Stmt struct {
+	graph  *Graph
+	query  string
+	params []string
+	known  map[string]bool
+}
+
+// Prepare validates a query with GRAPH.EXPLAIN and returns a statement
+// that binds parameters to it.
+//
+// Parameter names are extracted from the query text. Executing the statement
+// fails with ErrMissingParameter or ErrUnknownParameter, before any network
+// call, when the supplied parameters do not match them. Parameter names must
+// be identifiers; a backtick-quoted name such as $`my param` fails with
+// ErrInvalidParameter.
+//
+// Example:
+//
+//	stmt, err := graph.Prepare(ctx, "MATCH (p:Person {name: $name}) RETURN p")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, name := range names {
+//		result, err := stmt.ROQuery(ctx, map[string]interface{}{"name": name})
+//		// ...
+//	}
+func (g *Graph) Prepare(ctx context.Context, query string) (*Stmt, error) {
+	params, err := proto.ExtractParams(query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidParameter, err)
+	}
+	stmt := &Stmt{
+		graph:  g,
+		query:  query,
+		params: params,
+		known:  make(map[string]bool),
+	}
+
+	// Plan the query with every parameter bound to null
+	nulls := make(map[string]interface{}, len(stmt.params))
+	for _, name := range stmt.params {
+		stmt.known[name] = true
+		nulls[name] = nil
+	}
+
+	args, err := proto.BuildQueryArgs("GRAPH.EXPLAIN", g.name, query, nulls, 0, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidParameter, err)
+	}
+	if err := g.client.Do(ctx, args...).Err(); err != nil {
+		return nil, wrapError(err, g.name, query)
+	}
+
+	return stmt, nil
+}
+
+// Query executes the statement as a write query with the given parameters.
+// Additional options, such as WithTimeout, are applied as for Graph.Query.
+func (s *Stmt) Query(ctx context.Context, params map[string]interface{}, options ...QueryOption) (*QueryResult, error) {
+	return s.execute(ctx, "GRAPH.QUERY", params, options)
+}
+
+// ROQuery executes the statement as a read-only query with the given parameters.
+func (s *Stmt) ROQuery(ctx context.Context, params map[string]interface{}, options ...QueryOption) (*QueryResult, error) {
+	return s.execute(ctx, "GRAPH.RO_QUERY", params, options)
+}
+
+// Params returns the parameter names referenced by the statement.
+func (s *Stmt) Params() []string {
+	return append([]string(nil), s.params...)
+}
+
+// String returns the query text of the statement.
+func (s *Stmt) String() string {
+	return s.query
+}
+
+func (s *Stmt) execute(ctx context.Context, cmd string, params map[string]interface{}, options []QueryOption) (*QueryResult, error) {
+	options = append([]QueryOption{WithParams(params)}, options...)
+	if err := s.bind(options); err != nil {
+		return nil, err
+	}
+	return s.graph.execute(ctx, cmd, s.query, options...)
+}
+
+// bind checks the parameters supplied for an execution against the statement.
+// Graph defaults may satisfy a parameter but are not checked for unknown names,
+// as they are shared by every query on the graph.
+func (s *Stmt) bind(options []QueryOption) error {
+	supplied := resolveQueryOptions(nil, options)
+	for name := range supplied.Params {
+		if !s.known[name] {
+			return fmt.Errorf("%w: %q", ErrUnknownParameter, name)
+		}
+	}
+	for _, name := range s.params {
+		if hasParam(supplied.Params, name) {
+			continue
+		}
+		if s.graph.defaults == nil || !hasParam(s.graph.defaults.Params, name) {
+			return fmt.Errorf("%w: %q", ErrMissingParameter, name)
+		}
+	}
+	return nil
+}
+
+func hasParam(params map[string]interface{}, name string) bool {
+	_, ok := params[name]
+	return ok
+}
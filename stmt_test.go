@@ -0,0 +1,61 @@
+package falkordb
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPrepare(t *testing.T) {
+	client := &fakeClient{}
+	graph := newTestGraph(client, WithParam("tenant", "acme"))
+	ctx := context.Background()
+
+	stmt, err := graph.Prepare(ctx, "MATCH (p:Person {tenant: $tenant, name: $name}) RETURN p")
+	if err != nil {
+		t.Fatalf("Prepare failed: %v", err)
+	}
+	if !reflect.DeepEqual(stmt.Params(), []string{"tenant", "name"}) {
+		t.Errorf("Unexpected params: %v", stmt.Params())
+	}
+
+	explain := client.commands[0]
+	if explain[0] != "GRAPH.EXPLAIN" || !strings.HasPrefix(explain[2].(string), "CYPHER name=null tenant=null ") {
+		t.Errorf("Unexpected explain command: %v", explain)
+	}
+
+	if _, err := stmt.ROQuery(ctx, map[string]interface{}{"name": "Alice"}); err != nil {
+		t.Errorf("ROQuery failed: %v", err)
+	}
+	if _, err := stmt.ROQuery(ctx, nil, WithParam("name", "Bob")); err != nil {
+		t.Errorf("ROQuery with option params failed: %v", err)
+	}
+
+	sent := client.count("GRAPH.RO_QUERY")
+	_, err = stmt.ROQuery(ctx, map[string]interface{}{})
+	if !errors.Is(err, ErrMissingParameter) {
+		t.Errorf("Expected ErrMissingParameter, got %v", err)
+	}
+	_, err = stmt.ROQuery(ctx, map[string]interface{}{"name": "Alice", "extra": 1})
+	if !errors.Is(err, ErrUnknownParameter) {
+		t.Errorf("Expected ErrUnknownParameter, got %v", err)
+	}
+	if client.count("GRAPH.RO_QUERY") != sent {
+		t.Error("Invalid parameters should be rejected before any network call")
+	}
+}
+
+func TestPrepareQuotedParamName(t *testing.T) {
+	client := &fakeClient{}
+	graph := newTestGraph(client)
+
+	_, err := graph.Prepare(context.Background(), "RETURN $`my param`")
+	if !errors.Is(err, ErrInvalidParameter) {
+		t.Errorf("Expected ErrInvalidParameter, got %v", err)
+	}
+	if len(client.commands) != 0 {
+		t.Errorf("Expected no commands, got %v", client.commands)
+	}
+}
@@ -7,7 +7,9 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"os"
 	"strings"
@@ -15,6 +17,7 @@ import (
 	"time"
 
 	"github.com/flancast90/falkordb-go"
+	"github.com/flancast90/falkordb-go/cypher"
 )
 
 func init() {
@@ -27,6 +30,12 @@ func randomName() string {
 
 func newTestDB(t *testing.T) *falkordb.FalkorDB {
 	t.Helper()
+	return newTestDBWithOptions(t, &falkordb.Options{})
+}
+
+// newTestDBWithOptions connects with opts, filling in the test server address.
+func newTestDBWithOptions(t *testing.T, opts *falkordb.Options) *falkordb.FalkorDB {
+	t.Helper()
 
 	host := os.Getenv("FALKORDB_HOST")
 	if host == "" {
@@ -38,9 +47,8 @@ func newTestDB(t *testing.T) *falkordb.FalkorDB {
 	}
 
 	ctx := context.Background()
-	db, err := falkordb.Connect(ctx, &falkordb.Options{
-		Addr: fmt.Sprintf("%s:%s", host, port),
-	})
+	opts.Addr = fmt.Sprintf("%s:%s", host, port)
+	db, err := falkordb.Connect(ctx, opts)
 	if err != nil {
 		t.Skipf("FalkorDB not available at %s:%s: %v", host, port, err)
 	}
@@ -84,6 +92,53 @@ func TestConnection(t *testing.T) {
 		}
 	})
 
+	t.Run("ServerInfo", func(t *testing.T) {
+		info, err := db.ServerInfo(ctx)
+		if err != nil {
+			t.Fatalf("ServerInfo failed: %v", err)
+		}
+		if info.Version.Major == 0 {
+			t.Errorf("Expected a server version, got %v", info.Version)
+		}
+		if v, ok := info.FalkorDBVersion(); !ok || v.Major == 0 {
+			t.Errorf("Expected the FalkorDB module to be loaded, got %v (%v)", v, ok)
+		}
+		if info.Replication.Role == "" {
+			t.Error("Expected a replication role")
+		}
+	})
+
+	t.Run("Capabilities", func(t *testing.T) {
+		detecting := newTestDBWithOptions(t, &falkordb.Options{DetectCapabilities: true})
+		defer detecting.Close()
+
+		caps := detecting.Capabilities()
+		if !caps.Detected {
+			t.Fatal("Expected capabilities to be detected on Connect")
+		}
+
+		info, err := db.ServerInfo(ctx, "modules")
+		if err != nil {
+			t.Fatalf("ServerInfo failed: %v", err)
+		}
+		if v, _ := info.FalkorDBVersion(); v != caps.Version {
+			t.Errorf("Capabilities version %v does not match INFO version %v", caps.Version, v)
+		}
+		if !caps.Supports(falkordb.FeatureConstraints) {
+			t.Errorf("Expected constraints to be supported by %v", caps.Version)
+		}
+	})
+
+	t.Run("NodesInfo", func(t *testing.T) {
+		infos, err := db.NodesInfo(ctx, "server")
+		if err != nil {
+			t.Fatalf("NodesInfo failed: %v", err)
+		}
+		if len(infos) == 0 || infos[0].Addr == "" {
+			t.Errorf("Expected at least one node with an address, got %+v", infos)
+		}
+	})
+
 	t.Run("List", func(t *testing.T) {
 		graphs, err := db.List(ctx)
 		if err != nil {
@@ -93,6 +148,23 @@ func TestConnection(t *testing.T) {
 			t.Error("Expected non-nil graph list")
 		}
 	})
+
+	t.Run("ListGraphs", func(t *testing.T) {
+		name := randomName()
+		graph := db.SelectGraph(name)
+		defer graph.Delete(ctx)
+		if _, err := graph.Query(ctx, "CREATE (:Probe)"); err != nil {
+			t.Fatalf("Query failed: %v", err)
+		}
+
+		listings, err := db.ListGraphs(ctx, name+"*")
+		if err != nil {
+			t.Fatalf("ListGraphs failed: %v", err)
+		}
+		if len(listings) != 1 || listings[0].Name != name || listings[0].Node == "" {
+			t.Errorf("Expected %s with its node, got %+v", name, listings)
+		}
+	})
 }
 
 // =============================================================================
@@ -145,6 +217,35 @@ func TestConfig(t *testing.T) {
 			t.Error("Expected error for invalid config key")
 		}
 	})
+
+	t.Run("GetAllConfig", func(t *testing.T) {
+		config, err := db.ConfigGetAll(ctx)
+		if err != nil {
+			t.Fatalf("ConfigGetAll failed: %v", err)
+		}
+		if config.ThreadCount <= 0 {
+			t.Errorf("Expected positive THREAD_COUNT, got %d", config.ThreadCount)
+		}
+
+		size, err := db.ConfigGet(ctx, "RESULTSET_SIZE")
+		if err != nil {
+			t.Fatalf("ConfigGet failed: %v", err)
+		}
+		if size != config.ResultSetSize {
+			t.Errorf("RESULTSET_SIZE = %v, ConfigGetAll reported %d", size, config.ResultSetSize)
+		}
+
+		if diffs := falkordb.DiffConfig(config, config); len(diffs) != 0 {
+			t.Errorf("Expected no differences, got %+v", diffs)
+		}
+	})
+
+	t.Run("SetConfigWrongType", func(t *testing.T) {
+		err := db.ConfigSet(ctx, "RESULTSET_SIZE", "lots")
+		if !errors.Is(err, falkordb.ErrInvalidConfig) {
+			t.Errorf("Expected ErrInvalidConfig, got %v", err)
+		}
+	})
 }
 
 // =============================================================================
@@ -182,6 +283,28 @@ func TestBasicQueries(t *testing.T) {
 		}
 	})
 
+	t.Run("Stats", func(t *testing.T) {
+		result, err := graph.Query(ctx, "CREATE (a:Stat {x: 1})-[:REL]->(b:Stat)")
+		if err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		if result.Stats.NodesCreated != 2 {
+			t.Errorf("Expected 2 nodes created, got %d", result.Stats.NodesCreated)
+		}
+		if result.Stats.RelationshipsCreated != 1 {
+			t.Errorf("Expected 1 relationship created, got %d", result.Stats.RelationshipsCreated)
+		}
+		if result.Stats.PropertiesSet != 1 {
+			t.Errorf("Expected 1 property set, got %d", result.Stats.PropertiesSet)
+		}
+		if !result.Stats.ContainsUpdates() {
+			t.Error("Expected stats to report updates")
+		}
+		if result.Stats.ExecutionTime <= 0 {
+			t.Errorf("Expected positive execution time, got %v", result.Stats.ExecutionTime)
+		}
+	})
+
 	t.Run("ROQuery", func(t *testing.T) {
 		result, err := graph.ROQuery(ctx, "MATCH (n:Person) RETURN n.name")
 		if err != nil {
@@ -195,14 +318,57 @@ func TestBasicQueries(t *testing.T) {
 	t.Run("ROQueryWriteFails", func(t *testing.T) {
 		_, err := graph.ROQuery(ctx, "CREATE (n:Test)")
 		if err == nil {
-			t.Error("Expected error for write in ROQuery")
+			t.Fatal("Expected error for write in ROQuery")
+		}
+		if !errors.Is(err, falkordb.ErrReadOnly) {
+			t.Errorf("Expected ErrReadOnly, got %v", err)
 		}
 	})
 
 	t.Run("InvalidSyntax", func(t *testing.T) {
 		_, err := graph.Query(ctx, "THIS IS NOT CYPHER")
 		if err == nil {
-			t.Error("Expected error for invalid syntax")
+			t.Fatal("Expected error for invalid syntax")
+		}
+		if !errors.Is(err, falkordb.ErrSyntax) {
+			t.Errorf("Expected ErrSyntax, got %v", err)
+		}
+
+		var fErr *falkordb.Error
+		if !errors.As(err, &fErr) {
+			t.Fatalf("Expected *falkordb.Error, got %T", err)
+		}
+		if fErr.Query != "THIS IS NOT CYPHER" {
+			t.Errorf("Expected query to be preserved, got %q", fErr.Query)
+		}
+	})
+
+	t.Run("ContextDeadline", func(t *testing.T) {
+		deadlineCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		_, err := graph.ROQuery(deadlineCtx, "UNWIND range(0, 100000000) AS x RETURN count(x)")
+		if err == nil {
+			t.Fatal("Expected error for query exceeding context deadline")
+		}
+		if !errors.Is(err, falkordb.ErrQueryTimeout) && !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected timeout error, got %v", err)
+		}
+	})
+
+	t.Run("TimeoutDuration", func(t *testing.T) {
+		_, err := graph.ROQuery(ctx, "UNWIND range(0, 100000000) AS x RETURN count(x)",
+			&falkordb.QueryOptions{TimeoutDuration: time.Millisecond},
+		)
+		if !errors.Is(err, falkordb.ErrQueryTimeout) {
+			t.Errorf("Expected ErrQueryTimeout, got %v", err)
+		}
+	})
+
+	t.Run("UnknownFunction", func(t *testing.T) {
+		_, err := graph.Query(ctx, "RETURN noSuchFunction(1)")
+		if !errors.Is(err, falkordb.ErrUnknownFunction) {
+			t.Errorf("Expected ErrUnknownFunction, got %v", err)
 		}
 	})
 }
@@ -270,6 +436,101 @@ func TestQueryParameters(t *testing.T) {
 		}
 	})
 
+	t.Run("FunctionalOptions", func(t *testing.T) {
+		result, err := graph.Query(ctx,
+			"CREATE (n:Person {name: $name, age: $age}) RETURN n.name, n.age",
+			falkordb.WithParam("name", "Dana"),
+			&falkordb.QueryOptions{Params: map[string]interface{}{"age": 41}},
+			falkordb.WithTimeout(5*time.Second),
+		)
+		if err != nil {
+			t.Fatalf("Query with functional options failed: %v", err)
+		}
+		if result.Data[0]["n.name"] != "Dana" || result.Data[0]["n.age"] != int64(41) {
+			t.Errorf("Unexpected row: %v", result.Data[0])
+		}
+	})
+
+	t.Run("GraphDefaults", func(t *testing.T) {
+		tagged := db.SelectGraph(graph.Name(),
+			falkordb.WithParam("name", "Bob"),
+			falkordb.WithTag("test", "defaults"),
+		)
+
+		result, err := tagged.ROQuery(ctx, "MATCH (n:Person {name: $name}) RETURN count(n) AS c")
+		if err != nil {
+			t.Fatalf("Query with graph defaults failed: %v", err)
+		}
+		if result.Data[0]["c"] != int64(1) {
+			t.Errorf("Expected 1 match, got %v", result.Data[0]["c"])
+		}
+
+		_, err = tagged.Query(ctx, "THIS IS NOT CYPHER")
+		var fErr *falkordb.Error
+		if !errors.As(err, &fErr) {
+			t.Fatalf("Expected *falkordb.Error, got %v", err)
+		}
+		if fErr.Tags["test"] != "defaults" {
+			t.Errorf("Expected tags on error, got %v", fErr.Tags)
+		}
+	})
+
+	t.Run("TypedSliceAndStructParams", func(t *testing.T) {
+		type profile struct {
+			Name    string   `falkordb:"name"`
+			Aliases []string `falkordb:"aliases"`
+			Ignored string   `falkordb:"-"`
+		}
+
+		result, err := graph.Query(ctx,
+			"CREATE (n:Person {name: $p.name, aliases: $p.aliases, scores: $scores}) RETURN n.aliases, n.scores",
+			falkordb.WithParam("p", profile{Name: "Erin", Aliases: []string{"E", "Rin"}}),
+			falkordb.WithParam("scores", []int64{1, 2, 3}),
+		)
+		if err != nil {
+			t.Fatalf("Query with typed params failed: %v", err)
+		}
+		aliases, ok := result.Data[0]["n.aliases"].([]interface{})
+		if !ok || len(aliases) != 2 || aliases[0] != "E" {
+			t.Errorf("Unexpected aliases: %v", result.Data[0]["n.aliases"])
+		}
+		scores, ok := result.Data[0]["n.scores"].([]interface{})
+		if !ok || len(scores) != 3 || scores[2] != int64(3) {
+			t.Errorf("Unexpected scores: %v", result.Data[0]["n.scores"])
+		}
+	})
+
+	t.Run("UnsupportedParam", func(t *testing.T) {
+		for name, value := range map[string]interface{}{
+			"channel": make(chan int),
+			"NaN":     math.NaN(),
+			"UTF-8":   "bad\xff",
+		} {
+			_, err := graph.Query(ctx, "RETURN $c", falkordb.WithParam("c", value))
+			if !errors.Is(err, falkordb.ErrInvalidParameter) {
+				t.Errorf("%s: expected ErrInvalidParameter, got %v", name, err)
+			}
+		}
+
+		_, err := graph.Query(ctx, "RETURN 1", falkordb.WithParam("x=1 RETURN 2 //", 1))
+		if !errors.Is(err, falkordb.ErrInvalidParameter) {
+			t.Errorf("Expected ErrInvalidParameter for unsafe name, got %v", err)
+		}
+	})
+
+	t.Run("UnusualMapKeys", func(t *testing.T) {
+		result, err := graph.Query(ctx, "RETURN $m AS m",
+			falkordb.WithParam("m", map[string]interface{}{"first name": "Ann", "a`b": 1}),
+		)
+		if err != nil {
+			t.Fatalf("Query with unusual map keys failed: %v", err)
+		}
+		m, ok := result.Data[0]["m"].(map[string]interface{})
+		if !ok || m["first name"] != "Ann" || m["a`b"] != int64(1) {
+			t.Errorf("Unexpected map: %v", result.Data[0]["m"])
+		}
+	})
+
 	t.Run("ArrayParam", func(t *testing.T) {
 		result, err := graph.Query(ctx,
 			"CREATE (n:Person {hobbies: $hobbies}) RETURN n.hobbies",
@@ -292,6 +553,168 @@ func TestQueryParameters(t *testing.T) {
 	})
 }
 
+// =============================================================================
+// Prepared Statement Tests
+// =============================================================================
+
+func TestPreparedStatements(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	graph := db.SelectGraph(randomName())
+	defer graph.Delete(ctx)
+
+	_, _ = graph.Query(ctx, "UNWIND range(1, 5) AS i CREATE (:Item {id: i})")
+
+	t.Run("Execute", func(t *testing.T) {
+		stmt, err := graph.Prepare(ctx, "MATCH (n:Item) WHERE n.id >= $min AND n.id <= $max RETURN count(n) AS c")
+		if err != nil {
+			t.Fatalf("Prepare failed: %v", err)
+		}
+
+		for _, tc := range []struct{ min, max, expected int64 }{{1, 5, 5}, {2, 3, 2}, {6, 9, 0}} {
+			result, err := stmt.ROQuery(ctx, map[string]interface{}{"min": tc.min, "max": tc.max})
+			if err != nil {
+				t.Fatalf("Statement execution failed: %v", err)
+			}
+			if result.Data[0]["c"] != tc.expected {
+				t.Errorf("[%d, %d]: expected %d, got %v", tc.min, tc.max, tc.expected, result.Data[0]["c"])
+			}
+		}
+	})
+
+	t.Run("InvalidQuery", func(t *testing.T) {
+		_, err := graph.Prepare(ctx, "MATCH (n RETURN n")
+		if !errors.Is(err, falkordb.ErrSyntax) {
+			t.Errorf("Expected ErrSyntax, got %v", err)
+		}
+	})
+
+	t.Run("ParameterMismatch", func(t *testing.T) {
+		stmt, err := graph.Prepare(ctx, "MATCH (n:Item {id: $id}) RETURN n")
+		if err != nil {
+			t.Fatalf("Prepare failed: %v", err)
+		}
+		if _, err := stmt.ROQuery(ctx, nil); !errors.Is(err, falkordb.ErrMissingParameter) {
+			t.Errorf("Expected ErrMissingParameter, got %v", err)
+		}
+		if _, err := stmt.ROQuery(ctx, map[string]interface{}{"id": 1, "x": 2}); !errors.Is(err, falkordb.ErrUnknownParameter) {
+			t.Errorf("Expected ErrUnknownParameter, got %v", err)
+		}
+	})
+}
+
+// =============================================================================
+// Query Builder Tests
+// =============================================================================
+
+func TestQueryBuilder(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	graph := db.SelectGraph(randomName())
+	defer graph.Delete(ctx)
+
+	query, params, err := cypher.New().
+		Unwind([]map[string]interface{}{{"name": "Alice", "age": 30}, {"name": "Bob", "age": 25}}, "row").
+		Create(cypher.Node("p", "Person").Props(map[string]interface{}{
+			"name": cypher.Prop("row", "name"),
+			"age":  cypher.Prop("row", "age"),
+		})).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	result, err := graph.Query(ctx, query, falkordb.WithParams(params))
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if result.Stats.NodesCreated != 2 {
+		t.Errorf("Expected 2 nodes created, got %d", result.Stats.NodesCreated)
+	}
+
+	query, params, err = cypher.New().
+		Match(cypher.Node("p", "Person")).
+		Where(cypher.Prop("p", "age").Gt(26)).
+		Return(cypher.Prop("p", "name").As("name")).
+		OrderBy(cypher.Prop("p", "name")).
+		Limit(5).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	result, err = graph.ROQuery(ctx, query, falkordb.WithParams(params))
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if len(result.Data) != 1 || result.Data[0]["name"] != "Alice" {
+		t.Errorf("Unexpected result: %v", result.Data)
+	}
+}
+
+// =============================================================================
+// Result Cache Tests
+// =============================================================================
+
+func TestResultCache(t *testing.T) {
+	channel := "falkordb-cache-" + randomName()
+	cached := newTestDBWithOptions(t, &falkordb.Options{
+		ResultCache: &falkordb.CacheOptions{TTL: time.Minute, InvalidationChannel: channel},
+	})
+	defer cached.Close()
+
+	ctx := context.Background()
+	name := randomName()
+	graph := cached.SelectGraph(name)
+	defer graph.Delete(ctx)
+
+	count := func() int64 {
+		t.Helper()
+		result, err := graph.ROQuery(ctx, "MATCH (n:Item) RETURN count(n) AS c")
+		if err != nil {
+			t.Fatalf("ROQuery failed: %v", err)
+		}
+		return result.Data[0]["c"].(int64)
+	}
+
+	if _, err := graph.Query(ctx, "CREATE (:Item)"); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if c := count(); c != 1 {
+		t.Fatalf("Expected 1 item, got %d", c)
+	}
+
+	t.Run("LocalWrite", func(t *testing.T) {
+		if _, err := graph.Query(ctx, "CREATE (:Item)"); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		if c := count(); c != 2 {
+			t.Errorf("Expected write to invalidate the cache, got %d items", c)
+		}
+	})
+
+	t.Run("RemoteWrite", func(t *testing.T) {
+		other := newTestDBWithOptions(t, &falkordb.Options{
+			ResultCache: &falkordb.CacheOptions{InvalidationChannel: channel},
+		})
+		defer other.Close()
+
+		if _, err := other.SelectGraph(name).Query(ctx, "CREATE (:Item)"); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+
+		deadline := time.Now().Add(2 * time.Second)
+		for count() != 3 {
+			if time.Now().After(deadline) {
+				t.Fatal("Cache was not invalidated by the other client")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	})
+}
+
 // =============================================================================
 // Node and Edge Tests
 // =============================================================================
@@ -521,6 +944,38 @@ func TestGraphOperations(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("MemoryUsage", func(t *testing.T) {
+		name := randomName()
+		graph := db.SelectGraph(name)
+		defer graph.Delete(ctx)
+
+		_, err := graph.Query(ctx, "UNWIND range(1, 1000) AS i CREATE (:Person {id: i, name: 'p' + toString(i)})")
+		if err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+
+		report, err := graph.MemoryUsage(ctx, 100)
+		if err != nil {
+			t.Fatalf("MemoryUsage failed: %v", err)
+		}
+		if _, ok := report.NodeAttributesByLabelMB["Person"]; !ok {
+			t.Errorf("Expected Person attribute storage in report: %+v", report)
+		}
+		t.Logf("Memory usage: %+v", report)
+
+		capacity, err := db.CapacityReport(ctx, 0)
+		if err != nil {
+			t.Fatalf("CapacityReport failed: %v", err)
+		}
+		found := false
+		for _, g := range capacity.Graphs {
+			found = found || g.Name == name
+		}
+		if !found {
+			t.Errorf("Expected %s in capacity report", name)
+		}
+	})
 }
 
 // =============================================================================
@@ -544,15 +999,30 @@ func TestQueryAnalysis(t *testing.T) {
 			t.Fatalf("Explain failed: %v", err)
 		}
 
-		if len(plan) == 0 {
-			t.Error("Expected non-empty plan")
+		if plan.Root == nil || plan.Root.Name != "Results" {
+			t.Errorf("Expected Results at the root, got %+v", plan.Root)
+		}
+		if plan.Profiled {
+			t.Error("Explain plan should not be profiled")
 		}
+		t.Logf("Execution plan:\n%s", plan)
+	})
 
-		planStr := strings.Join(plan, "\n")
-		if !strings.Contains(planStr, "Results") {
-			t.Error("Expected 'Results' in plan")
+	t.Run("ExplainWithParams", func(t *testing.T) {
+		plan, err := graph.Explain(ctx, "MATCH (n:Person {name: $name}) RETURN n", falkordb.WithParam("name", "Alice"))
+		if err != nil {
+			t.Fatalf("Explain failed: %v", err)
+		}
+
+		found := false
+		for _, op := range plan.Operations() {
+			if strings.Contains(op.Args, "n:Person") {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Expected an operation on n:Person in plan:\n%s", plan)
 		}
-		t.Logf("Execution plan:\n%s", planStr)
 	})
 
 	t.Run("Profile", func(t *testing.T) {
@@ -561,15 +1031,16 @@ func TestQueryAnalysis(t *testing.T) {
 			t.Fatalf("Profile failed: %v", err)
 		}
 
-		if len(profile) == 0 {
-			t.Error("Expected non-empty profile")
+		if !profile.Profiled || profile.Root == nil {
+			t.Fatalf("Expected a profiled plan, got:\n%s", profile)
 		}
-
-		profileStr := strings.Join(profile, "\n")
-		if !strings.Contains(profileStr, "Records produced") {
+		if profile.Root.RecordsProduced != 1 {
+			t.Errorf("Expected 1 record produced, got %d", profile.Root.RecordsProduced)
+		}
+		if !strings.Contains(profile.String(), "Records produced") {
 			t.Error("Expected 'Records produced' in profile")
 		}
-		t.Logf("Profile:\n%s", profileStr)
+		t.Logf("Profile:\n%s", profile)
 	})
 
 	t.Run("SlowLog", func(t *testing.T) {
@@ -586,7 +1057,92 @@ func TestQueryAnalysis(t *testing.T) {
 			if i >= 3 {
 				break
 			}
-			t.Logf("  [%d] %s: %s (%.2fms)", entry.Timestamp, entry.Command, entry.Query, entry.Took)
+			if entry.Timestamp.IsZero() || entry.Took <= 0 {
+				t.Errorf("Unexpected entry: %+v", entry)
+			}
+			t.Logf("  [%s] %s: %s (%v)", entry.Timestamp.Format(time.RFC3339), entry.Command, entry.Query, entry.Took)
+		}
+	})
+
+	t.Run("SlowLogReset", func(t *testing.T) {
+		if err := graph.SlowLogReset(ctx); err != nil {
+			t.Fatalf("SlowLogReset failed: %v", err)
+		}
+		entries, err := graph.SlowLog(ctx)
+		if err != nil {
+			t.Fatalf("SlowLog failed: %v", err)
+		}
+		if len(entries) != 0 {
+			t.Errorf("Expected empty slow log after reset, got %d entries", len(entries))
+		}
+	})
+
+	t.Run("WatchSlowLog", func(t *testing.T) {
+		watchCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		entries, _ := graph.WatchSlowLog(watchCtx, 50*time.Millisecond)
+		time.Sleep(100 * time.Millisecond)
+		_, _ = graph.Query(ctx, "UNWIND range(0, 200000) AS x RETURN sum(x)")
+
+		select {
+		case entry := <-entries:
+			if !strings.Contains(entry.Query, "range(0, 200000)") {
+				t.Errorf("Unexpected entry: %+v", entry)
+			}
+		case <-watchCtx.Done():
+			t.Skip("Query was not slow enough to be logged")
+		}
+	})
+
+	t.Run("Lint", func(t *testing.T) {
+		findings, err := graph.Lint(ctx, "MATCH (n) RETURN n")
+		if err != nil {
+			t.Fatalf("Lint failed: %v", err)
+		}
+		if len(findings) != 1 || findings[0].Rule != falkordb.LintAllNodeScan {
+			t.Errorf("Expected an all-node-scan finding, got %v", findings)
+		}
+
+		query := "MATCH (n:Person) WHERE n.name = $name RETURN n"
+		findings, err = graph.Lint(ctx, query, falkordb.WithParam("name", "Alice"))
+		if err != nil {
+			t.Fatalf("Lint failed: %v", err)
+		}
+		if len(findings) != 1 || findings[0].Rule != falkordb.LintUnindexedFilter || findings[0].Property != "name" {
+			t.Fatalf("Expected an unindexed-filter finding on name, got %v", findings)
+		}
+
+		if _, err := graph.CreateNodeRangeIndex(ctx, findings[0].Label, findings[0].Property); err != nil {
+			t.Fatalf("CreateNodeRangeIndex failed: %v", err)
+		}
+		findings, err = graph.Lint(ctx, query, falkordb.WithParam("name", "Alice"))
+		if err != nil {
+			t.Fatalf("Lint failed: %v", err)
+		}
+		if len(findings) != 0 {
+			t.Errorf("Expected no findings after indexing, got %v", findings)
+		}
+	})
+
+	t.Run("Guard", func(t *testing.T) {
+		guarded := db.SelectGraph(graph.Name(), falkordb.WithGuard(falkordb.NewQueryGuard()))
+
+		_, err := guarded.ROQuery(ctx, "MATCH (n) RETURN n")
+		var gErr *falkordb.GuardError
+		if !errors.As(err, &gErr) || !errors.Is(err, falkordb.ErrQueryRejected) {
+			t.Fatalf("Expected *GuardError, got %v", err)
+		}
+		if gErr.Findings[0].Operation == nil {
+			t.Error("Expected the offending operation in the finding")
+		}
+
+		result, err := guarded.ROQuery(ctx, "MATCH (n:Person) RETURN n LIMIT 10")
+		if err != nil {
+			t.Fatalf("Guarded query failed: %v", err)
+		}
+		if len(result.Data) != 1 {
+			t.Errorf("Expected 1 row, got %d", len(result.Data))
 		}
 	})
 }
@@ -640,6 +1196,32 @@ func TestIndices(t *testing.T) {
 		}
 	})
 
+	t.Run("QuotedIdentifiers", func(t *testing.T) {
+		_, _ = graph.Query(ctx, "CREATE (:`Odd Label` {`first name`: 'Ann'})")
+
+		if _, err := graph.CreateNodeRangeIndex(ctx, "Odd Label", "first name"); err != nil {
+			t.Fatalf("CreateNodeRangeIndex with quoted identifiers failed: %v", err)
+		}
+		if _, err := graph.DropNodeRangeIndex(ctx, "Odd Label", "first name"); err != nil {
+			t.Fatalf("DropNodeRangeIndex with quoted identifiers failed: %v", err)
+		}
+	})
+
+	t.Run("InjectionInLabel", func(t *testing.T) {
+		_, err := graph.CreateNodeRangeIndex(ctx, "Person) ON (e.name) //", "name")
+		if err != nil {
+			t.Logf("CreateNodeRangeIndex with hostile label: %v", err)
+		}
+
+		result, err := graph.ROQuery(ctx, "MATCH (n:Person) RETURN count(n) AS c")
+		if err != nil {
+			t.Fatalf("Count failed: %v", err)
+		}
+		if result.Data[0]["c"] != int64(1) {
+			t.Errorf("Expected data to be untouched, got %v", result.Data[0]["c"])
+		}
+	})
+
 	t.Run("DuplicateIndexFails", func(t *testing.T) {
 		graph.CreateNodeRangeIndex(ctx, "Person", "name")
 		_, err := graph.CreateNodeRangeIndex(ctx, "Person", "name")
@@ -692,6 +1274,22 @@ func TestConstraints(t *testing.T) {
 		}
 	})
 
+	t.Run("UniqueConstraintViolation", func(t *testing.T) {
+		err := graph.ConstraintCreate(ctx, falkordb.ConstraintUnique, falkordb.EntityNode, "Person", "email")
+		if err != nil {
+			t.Fatalf("ConstraintCreate failed: %v", err)
+		}
+		defer graph.ConstraintDrop(ctx, falkordb.ConstraintUnique, falkordb.EntityNode, "Person", "email")
+
+		// Constraint creation is asynchronous; wait for it to become operational
+		time.Sleep(500 * time.Millisecond)
+
+		_, err = graph.Query(ctx, "CREATE (:Person {name: 'Eve', email: 'alice@example.com'})")
+		if !errors.Is(err, falkordb.ErrConstraintViolation) {
+			t.Errorf("Expected ErrConstraintViolation, got %v", err)
+		}
+	})
+
 	t.Run("DuplicateConstraintFails", func(t *testing.T) {
 		graph.ConstraintCreate(ctx, falkordb.ConstraintMandatory, falkordb.EntityNode, "Person", "name")
 		err := graph.ConstraintCreate(ctx, falkordb.ConstraintMandatory, falkordb.EntityNode, "Person", "name")
@@ -729,6 +1327,125 @@ func TestDataTypes(t *testing.T) {
 		}
 	})
 
+	t.Run("TemporalAndSpatialParams", func(t *testing.T) {
+		result, err := graph.Query(ctx,
+			"RETURN $d.year AS year, $t.minute AS minute, $ts.day AS day, $p.latitude AS lat",
+			falkordb.WithParam("d", falkordb.Date{Year: 2024, Month: 3, Day: 1}),
+			falkordb.WithParam("t", falkordb.Time{Hour: 10, Minute: 30}),
+			falkordb.WithParam("ts", time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)),
+			falkordb.WithParam("p", &falkordb.Point{Latitude: 40.7128, Longitude: -74.006}),
+		)
+		if err != nil {
+			t.Fatalf("Temporal params query failed: %v", err)
+		}
+
+		row := result.Data[0]
+		if row["year"] != int64(2024) {
+			t.Errorf("Expected year 2024, got %v", row["year"])
+		}
+		if row["minute"] != int64(30) {
+			t.Errorf("Expected minute 30, got %v", row["minute"])
+		}
+		if row["day"] != int64(15) {
+			t.Errorf("Expected day 15, got %v", row["day"])
+		}
+		if lat, ok := row["lat"].(float64); !ok || lat < 40.7 || lat > 40.8 {
+			t.Errorf("Unexpected latitude: %v", row["lat"])
+		}
+	})
+
+	t.Run("TemporalValues", func(t *testing.T) {
+		result, err := graph.Query(ctx, `RETURN
+			localdatetime('2024-03-15T12:30:45') AS dt,
+			date('2024-03-15') AS d,
+			localtime('12:30:45') AS t,
+			duration('P2DT3H4S') AS dur,
+			[date('2024-03-15')] AS arr,
+			{at: date('2024-03-15')} AS m`)
+		if err != nil {
+			t.Fatalf("Temporal query failed: %v", err)
+		}
+		row := result.Data[0]
+
+		dt, ok := row["dt"].(*falkordb.DateTime)
+		if !ok || !dt.Equal(time.Date(2024, 3, 15, 12, 30, 45, 0, time.UTC)) {
+			t.Errorf("Unexpected datetime: %v (%T)", row["dt"], row["dt"])
+		}
+		d, ok := row["d"].(*falkordb.Date)
+		if !ok || d.String() != "2024-03-15" {
+			t.Errorf("Unexpected date: %v (%T)", row["d"], row["d"])
+		}
+		tm, ok := row["t"].(*falkordb.Time)
+		if !ok || tm.String() != "12:30:45" {
+			t.Errorf("Unexpected time: %v (%T)", row["t"], row["t"])
+		}
+		dur, ok := row["dur"].(*falkordb.Duration)
+		if !ok || dur.ToDuration() != 51*time.Hour+4*time.Second {
+			t.Errorf("Unexpected duration: %v (%T)", row["dur"], row["dur"])
+		}
+		if arr, ok := row["arr"].([]interface{}); !ok || len(arr) != 1 {
+			t.Errorf("Unexpected array: %v", row["arr"])
+		} else if _, ok := arr[0].(*falkordb.Date); !ok {
+			t.Errorf("Expected *Date in array, got %T", arr[0])
+		}
+		if m, ok := row["m"].(map[string]interface{}); !ok {
+			t.Errorf("Unexpected map: %v", row["m"])
+		} else if _, ok := m["at"].(*falkordb.Date); !ok {
+			t.Errorf("Expected *Date in map, got %T", m["at"])
+		}
+	})
+
+	t.Run("TemporalRoundTrip", func(t *testing.T) {
+		at := time.Date(2024, 3, 15, 12, 30, 45, 0, time.UTC)
+		result, err := graph.Query(ctx,
+			"CREATE (e:Event {at: $at, on: $on, took: $took}) RETURN e",
+			falkordb.WithParam("at", at),
+			falkordb.WithParam("on", &falkordb.Date{Year: 2024, Month: 3, Day: 15}),
+			falkordb.WithParam("took", &falkordb.Duration{Hours: 1, Minutes: 30}),
+		)
+		if err != nil {
+			t.Fatalf("Temporal round trip failed: %v", err)
+		}
+
+		event, ok := result.Data[0]["e"].(*falkordb.Node)
+		if !ok {
+			t.Fatalf("Expected node, got %T", result.Data[0]["e"])
+		}
+		if dt, ok := event.Properties["at"].(*falkordb.DateTime); !ok || !dt.Equal(at) {
+			t.Errorf("Unexpected at: %v (%T)", event.Properties["at"], event.Properties["at"])
+		}
+		if d, ok := event.Properties["on"].(*falkordb.Date); !ok || d.String() != "2024-03-15" {
+			t.Errorf("Unexpected on: %v (%T)", event.Properties["on"], event.Properties["on"])
+		}
+		if dur, ok := event.Properties["took"].(*falkordb.Duration); !ok || dur.ToDuration() != 90*time.Minute {
+			t.Errorf("Unexpected took: %v (%T)", event.Properties["took"], event.Properties["took"])
+		}
+	})
+
+	t.Run("VectorF32", func(t *testing.T) {
+		embedding := []float32{0.1, 0.2, 1.0 / 3.0}
+		result, err := graph.Query(ctx,
+			"CREATE (d:Doc {embedding: $e}) RETURN d.embedding AS e",
+			falkordb.WithParam("e", falkordb.VectorF32(embedding)),
+		)
+		if err != nil {
+			t.Fatalf("Vector query failed: %v", err)
+		}
+
+		vec, ok := result.Data[0]["e"].([]float32)
+		if !ok {
+			t.Fatalf("Expected []float32, got %T", result.Data[0]["e"])
+		}
+		if len(vec) != len(embedding) {
+			t.Fatalf("Expected %d elements, got %d", len(embedding), len(vec))
+		}
+		for i := range embedding {
+			if vec[i] != embedding[i] {
+				t.Errorf("Element %d: expected %v, got %v", i, embedding[i], vec[i])
+			}
+		}
+	})
+
 	t.Run("Map", func(t *testing.T) {
 		result, err := graph.Query(ctx, "RETURN {name: 'Alice', age: 30, nested: {city: 'NYC'}} AS m")
 		if err != nil {
@@ -833,4 +1550,74 @@ func TestConcurrency(t *testing.T) {
 			t.Errorf("Expected 5 nodes, got %v", count)
 		}
 	})
+
+	t.Run("DeduplicatedReads", func(t *testing.T) {
+		dedup := db.SelectGraph(graph.Name(), falkordb.WithDedup())
+
+		done := make(chan error, 20)
+		for i := 0; i < 20; i++ {
+			go func() {
+				result, err := dedup.ROQuery(ctx, "MATCH (n:Node) WHERE n.id <= $max RETURN count(n) AS c", falkordb.WithParam("max", 50))
+				if err == nil && result.Data[0]["c"] != int64(50) {
+					err = fmt.Errorf("expected 50, got %v", result.Data[0]["c"])
+				}
+				done <- err
+			}()
+		}
+
+		for i := 0; i < 20; i++ {
+			if err := <-done; err != nil {
+				t.Errorf("Deduplicated query failed: %v", err)
+			}
+		}
+	})
+
+	t.Run("HedgedReads", func(t *testing.T) {
+		hedge := falkordb.NewHedge(0.9, time.Millisecond)
+		hedged := db.SelectGraph(graph.Name(), falkordb.WithHedge(hedge))
+
+		for i := 0; i < 30; i++ {
+			result, err := hedged.ROQuery(ctx, "MATCH (n:Node) RETURN count(n) AS c")
+			if err != nil {
+				t.Fatalf("Hedged query failed: %v", err)
+			}
+			if result.Data[0]["c"] != int64(100) {
+				t.Fatalf("Expected 100, got %v", result.Data[0]["c"])
+			}
+		}
+		t.Logf("Hedge delay after 30 queries: %v", hedge.Delay())
+	})
+
+	t.Run("RunningQueries", func(t *testing.T) {
+		const slow = "UNWIND range(1, 3000000) AS x WITH x WHERE x % 7 = 0 RETURN count(x)"
+		done := make(chan error, 1)
+		go func() {
+			_, err := graph.ROQuery(ctx, slow)
+			done <- err
+		}()
+
+		found := false
+		for i := 0; i < 100 && !found; i++ {
+			running, err := db.RunningQueries(ctx)
+			if err != nil {
+				t.Fatalf("RunningQueries failed: %v", err)
+			}
+			for _, q := range running {
+				if q.Query == slow && q.Graph == graph.Name() && !q.ReceivedAt.IsZero() {
+					found = true
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		if err := <-done; err != nil {
+			t.Fatalf("Slow query failed: %v", err)
+		}
+		if !found {
+			t.Skip("Query finished before it was observed")
+		}
+
+		if _, err := db.WaitingQueries(ctx); err != nil {
+			t.Errorf("WaitingQueries failed: %v", err)
+		}
+	})
 }
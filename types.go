@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
 )
 
 // ConstraintType represents the type of constraint.
@@ -28,6 +30,13 @@ const (
 	EntityRelationship EntityType = "RELATIONSHIP"
 )
 
+// CypherMarshaler is implemented by types that encode themselves as a Cypher
+// expression when passed as a query parameter, similar to json.Marshaler.
+// The returned text is inserted verbatim, so it must be a valid expression.
+type CypherMarshaler interface {
+	MarshalCypher() (string, error)
+}
+
 // Node represents a graph node with labels and properties.
 type Node struct {
 	// ID is the internal node identifier.
@@ -110,6 +119,50 @@ func (p *Point) String() string {
 	return fmt.Sprintf("POINT(%f %f)", p.Latitude, p.Longitude)
 }
 
+// MarshalCypher encodes the point as a Cypher point() call.
+func (p Point) MarshalCypher() (string, error) {
+	coords, err := proto.ValueToString(map[string]interface{}{
+		"latitude":  p.Latitude,
+		"longitude": p.Longitude,
+	})
+	if err != nil {
+		return "", err
+	}
+	return "point(" + coords + ")", nil
+}
+
+// VectorF32 is a float32 vector, as stored in vector indexes.
+//
+// Plain []float32 and []float64 parameters are sent as lists; wrap them in
+// VectorF32 to send them as a vecf32() vector instead:
+//
+//	graph.Query(ctx, "CREATE (:Doc {embedding: $e})",
+//		falkordb.WithParam("e", falkordb.VectorF32(embedding)),
+//	)
+type VectorF32 []float32
+
+// VectorF32FromFloat64 converts v to a VectorF32, rounding each element to float32.
+func VectorF32FromFloat64(v []float64) VectorF32 {
+	vec := make(VectorF32, len(v))
+	for i, f := range v {
+		vec[i] = float32(f)
+	}
+	return vec
+}
+
+// MarshalCypher encodes the vector as a Cypher vecf32() call.
+// Elements are written with the shortest text that round-trips at float32 precision.
+func (v VectorF32) MarshalCypher() (string, error) {
+	if v == nil {
+		return "null", nil
+	}
+	list, err := proto.ValueToString([]float32(v))
+	if err != nil {
+		return "", err
+	}
+	return "vecf32(" + list + ")", nil
+}
+
 // Duration represents a temporal duration.
 type Duration struct {
 	Years       int
@@ -134,37 +187,50 @@ func (d *Duration) ToDuration() time.Duration {
 	return total
 }
 
-// String returns the ISO 8601 duration string.
+// String returns the ISO 8601 duration string. Negative components keep
+// their sign, as in "PT-2H"; seconds and nanoseconds are combined into a
+// single, possibly fractional, seconds component.
 func (d *Duration) String() string {
-	var parts []string
-
-	if d.Years > 0 {
-		parts = append(parts, fmt.Sprintf("%dY", d.Years))
-	}
-	if d.Months > 0 {
-		parts = append(parts, fmt.Sprintf("%dM", d.Months))
-	}
-	if d.Days > 0 {
-		parts = append(parts, fmt.Sprintf("%dD", d.Days))
+	var date, clock []string
+	unit := func(parts []string, n int, designator string) []string {
+		if n != 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", n, designator))
+		}
+		return parts
 	}
 
-	if d.Hours > 0 || d.Minutes > 0 || d.Seconds > 0 {
-		parts = append(parts, "T")
-		if d.Hours > 0 {
-			parts = append(parts, fmt.Sprintf("%dH", d.Hours))
-		}
-		if d.Minutes > 0 {
-			parts = append(parts, fmt.Sprintf("%dM", d.Minutes))
+	date = unit(date, d.Years, "Y")
+	date = unit(date, d.Months, "M")
+	date = unit(date, d.Days, "D")
+	clock = unit(clock, d.Hours, "H")
+	clock = unit(clock, d.Minutes, "M")
+
+	if nanos := int64(d.Seconds)*1e9 + int64(d.Nanoseconds); nanos != 0 {
+		sign := ""
+		if nanos < 0 {
+			sign, nanos = "-", -nanos
 		}
-		if d.Seconds > 0 {
-			parts = append(parts, fmt.Sprintf("%dS", d.Seconds))
+		if frac := nanos % 1e9; frac != 0 {
+			digits := strings.TrimRight(fmt.Sprintf("%09d", frac), "0")
+			clock = append(clock, fmt.Sprintf("%s%d.%sS", sign, nanos/1e9, digits))
+		} else {
+			clock = append(clock, fmt.Sprintf("%s%dS", sign, nanos/1e9))
 		}
 	}
 
-	if len(parts) == 0 {
+	if len(date) == 0 && len(clock) == 0 {
 		return "PT0S"
 	}
-	return "P" + strings.Join(parts, "")
+	s := "P" + strings.Join(date, "")
+	if len(clock) > 0 {
+		s += "T" + strings.Join(clock, "")
+	}
+	return s
+}
+
+// MarshalCypher encodes the duration as a Cypher duration() call.
+func (d Duration) MarshalCypher() (string, error) {
+	return temporalCall("duration", d.String())
 }
 
 // DateTime represents a date and time value.
@@ -172,6 +238,11 @@ type DateTime struct {
 	time.Time
 }
 
+// MarshalCypher encodes the value as a Cypher localdatetime() call in UTC.
+func (d DateTime) MarshalCypher() (string, error) {
+	return proto.FormatLocalDateTime(d.Time), nil
+}
+
 // Date represents a date without time.
 type Date struct {
 	Year  int
@@ -189,6 +260,11 @@ func (d *Date) String() string {
 	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
 }
 
+// MarshalCypher encodes the date as a Cypher date() call.
+func (d Date) MarshalCypher() (string, error) {
+	return temporalCall("date", d.String())
+}
+
 // Time represents a time without date.
 type Time struct {
 	Hour       int
@@ -204,3 +280,17 @@ func (t *Time) String() string {
 	}
 	return fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
 }
+
+// MarshalCypher encodes the time as a Cypher localtime() call.
+func (t Time) MarshalCypher() (string, error) {
+	return temporalCall("localtime", t.String())
+}
+
+// temporalCall returns a call to a Cypher temporal function with an ISO 8601 argument.
+func temporalCall(fn, iso string) (string, error) {
+	arg, err := proto.ValueToString(iso)
+	if err != nil {
+		return "", err
+	}
+	return fn + "(" + arg + ")", nil
+}
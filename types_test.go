@@ -0,0 +1,46 @@
+package falkordb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flancast90/falkordb-go/internal/proto"
+)
+
+func TestTemporalAndSpatialParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected string
+	}{
+		{"date", Date{Year: 2024, Month: 2, Day: 29}, `date("2024-02-29")`},
+		{"date pointer", &Date{Year: 2024, Month: 2, Day: 29}, `date("2024-02-29")`},
+		{"time", Time{Hour: 13, Minute: 5, Second: 9}, `localtime("13:05:09")`},
+		{"duration", Duration{Years: 1, Days: 2, Hours: 3, Seconds: 4}, `duration("P1Y2DT3H4S")`},
+		{"duration with fraction", &Duration{Seconds: 1, Nanoseconds: 500000000}, `duration("PT1.5S")`},
+		{"zero duration", Duration{}, `duration("PT0S")`},
+		{"negative duration", Duration{Days: -1, Hours: -2}, `duration("P-1DT-2H")`},
+		{"negative fraction", Duration{Seconds: -1, Nanoseconds: -500000000}, `duration("PT-1.5S")`},
+		{"mixed signs", Duration{Years: 1, Minutes: -30}, `duration("P1YT-30M")`},
+		{"datetime", DateTime{time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}, `localdatetime("2024-01-02T03:04:05")`},
+		{"point", &Point{Latitude: 40.5, Longitude: -74}, `point({latitude:40.5,longitude:-74.0})`},
+		{"nil point", (*Point)(nil), "null"},
+		{"vector", VectorF32{0.1, -2, 3.5e-8}, "vecf32([0.1,-2.0,3.5e-08])"},
+		{"vector from float64", VectorF32FromFloat64([]float64{0.1, 1}), "vecf32([0.1,1.0])"},
+		{"empty vector", VectorF32{}, "vecf32([])"},
+		{"nil vector", VectorF32(nil), "null"},
+		{"float32 list", []float32{0.1}, "[0.1]"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := proto.ValueToString(tc.input)
+			if err != nil {
+				t.Fatalf("ValueToString failed: %v", err)
+			}
+			if result != tc.expected {
+				t.Errorf("got %s, expected %s", result, tc.expected)
+			}
+		})
+	}
+}